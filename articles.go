@@ -0,0 +1,367 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db/filter"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/repository"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func newArticlesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "articles",
+		Aliases: []string{"article"},
+		Short:   "Manage articles",
+	}
+
+	cmd.AddCommand(
+		newArticlesCreateCmd(),
+		newArticlesListCmd(),
+		newArticlesGetCmd(),
+		newArticlesUpdateCmd(),
+		newArticlesDeleteCmd(),
+		newArticlesRestoreCmd(),
+		newArticlesTagCmd(),
+		newArticlesAuthorsCmd(),
+		newArticlesRevisionsCmd(),
+		newArticlesDiffCmd(),
+		newArticlesRevertCmd(),
+		newArticlesImportCmd(),
+		newArticlesExportCmd(),
+	)
+	cmd.AddCommand(newArticlesStatusCmds()...)
+
+	return cmd
+}
+
+// articleFlags holds the flags that map onto the columns of an article.
+type articleFlags struct {
+	title     string
+	body      string
+	authorID  int
+	createdAt string
+}
+
+func (f *articleFlags) register(flags *pflag.FlagSet) {
+	flags.StringVar(&f.title, "title", "", "title of the article")
+	flags.StringVar(&f.body, "body", "", "body of the article")
+	flags.IntVar(&f.authorID, "author-id", 0, "id of the article's author")
+	flags.StringVar(&f.createdAt, "created-at", "", "creation time of the article (RFC 3339)")
+}
+
+// apply copies the flags that were set on the command line onto article and
+// returns the names of the columns that were changed.
+func (f *articleFlags) apply(flags *pflag.FlagSet, article *dbmodels.Article) ([]string, error) {
+	var cols []string
+
+	if flags.Changed("title") {
+		article.Title = f.title
+		cols = append(cols, dbmodels.ArticleColumns.Title)
+	}
+	if flags.Changed("body") {
+		article.Body = null.StringFrom(f.body)
+		cols = append(cols, dbmodels.ArticleColumns.Body)
+	}
+	if flags.Changed("author-id") {
+		article.AuthorID = f.authorID
+		cols = append(cols, dbmodels.ArticleColumns.AuthorID)
+	}
+	if flags.Changed("created-at") {
+		createdAt, err := time.Parse(time.RFC3339, f.createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --created-at: %w", err)
+		}
+		article.CreatedAt = null.TimeFrom(createdAt)
+		cols = append(cols, dbmodels.ArticleColumns.CreatedAt)
+	}
+
+	return cols, nil
+}
+
+func newArticlesCreateCmd() *cobra.Command {
+	var flags articleFlags
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an article",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var article dbmodels.Article
+			if _, err := flags.apply(cmd.Flags(), &article); err != nil {
+				return err
+			}
+
+			if err := repos().Articles.Insert(cmd.Context(), &article); err != nil {
+				return err
+			}
+
+			return printResult(cmd, article, articleTable(&article))
+		},
+	}
+
+	flags.register(cmd.Flags())
+	cmd.MarkFlagRequired("title")
+	cmd.MarkFlagRequired("author-id")
+
+	return cmd
+}
+
+func newArticlesListCmd() *cobra.Command {
+	var limit, offset, authorID int
+	var filters filterFlags
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List articles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			q, err := filters.query(filter.Articles)
+			if err != nil {
+				return err
+			}
+			deleted, err := filters.deletedScope()
+			if err != nil {
+				return err
+			}
+
+			articles, err := repos().Articles.List(cmd.Context(), repository.ArticleFilter{
+				AuthorID: authorID,
+				Query:    q,
+				Deleted:  deleted,
+				Limit:    limit,
+				Offset:   offset,
+			})
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, articles, articleTable(articles...))
+		},
+	}
+
+	cmd.Flags().IntVar(&authorID, "author-id", 0, "only list articles by this author")
+	cmd.Flags().IntVar(&limit, "limit", 50, "maximum number of articles to list")
+	cmd.Flags().IntVar(&offset, "offset", 0, "number of articles to skip")
+	filters.register(cmd.Flags())
+
+	return cmd
+}
+
+func newArticlesGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show an article",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			article, err := repos().Articles.Find(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, article, articleTable(article))
+		},
+	}
+}
+
+func newArticlesUpdateCmd() *cobra.Command {
+	var (
+		flags   articleFlags
+		version int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update the given columns of an article",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			article, err := repos().Articles.Find(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			cols, err := flags.apply(cmd.Flags(), article)
+			if err != nil {
+				return err
+			}
+			if len(cols) == 0 {
+				return errors.New("nothing to update, set at least one column flag")
+			}
+			if cmd.Flags().Changed("expect-version") {
+				article.Version = version
+			}
+
+			if err := repos().Articles.Update(cmd.Context(), article, cols...); err != nil {
+				return err
+			}
+
+			return printResult(cmd, article, articleTable(article))
+		},
+	}
+
+	flags.register(cmd.Flags())
+	cmd.Flags().IntVar(&version, "expect-version", 0, "only update the article if it is still at this version")
+
+	return cmd
+}
+
+func newArticlesDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete an article",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			article, err := repos().Articles.Find(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			if err := repos().Articles.Delete(cmd.Context(), id); err != nil {
+				return err
+			}
+
+			return printResult(cmd, article, articleTable(article))
+		},
+	}
+}
+
+func newArticlesRestoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <id>",
+		Short: "Restore a deleted article",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			article, err := repos().Articles.Restore(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, article, articleTable(article))
+		},
+	}
+}
+
+func newArticlesImportCmd() *cobra.Command {
+	var flags importFlags
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Bulk load articles from a file of JSON objects",
+		Long: `Bulk load articles from a file with one JSON object per article, such as
+JSON lines, using COPY. Objects have the fields title, body, author_id and
+optionally created_at. Use - to read from stdin. Nothing is imported if any
+article fails.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			dec := jsonDecoder(in)
+			n, err := dbmodels.CopyArticles(cmd.Context(), boil.GetContextDB(), func() (*dbmodels.Article, error) {
+				var article dbmodels.Article
+				if err := dec.Decode(&article); err != nil {
+					return nil, err
+				}
+				return &article, nil
+			}, flags.options(cmd, "articles")...)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "imported %d articles\n", n)
+			return nil
+		},
+	}
+
+	flags.register(cmd.Flags())
+
+	return cmd
+}
+
+func newArticlesExportCmd() *cobra.Command {
+	var authorID, batchSize int
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Write all articles as JSON lines",
+		Long: `Write all articles to stdout as JSON lines, in the format read by
+"articles import": the title, body, author_id and created_at of every
+article. Ids, versions, statuses and the other columns the database
+manages aren't exported, imported articles get new ones. Articles are read
+through a server-side cursor, so exports of any size run in constant
+memory.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mods := []qm.QueryMod{qm.OrderBy(dbmodels.ArticleColumns.ID)}
+			if authorID != 0 {
+				mods = append(mods, dbmodels.ArticleWhere.AuthorID.EQ(authorID))
+			}
+
+			out := bufio.NewWriter(cmd.OutOrStdout())
+			enc := json.NewEncoder(out)
+			err := dbmodels.Articles(mods...).EachCursor(cmd.Context(), boil.GetContextDB(), batchSize, func(article *dbmodels.Article) error {
+				return enc.Encode(newArticleRecord(dbmodels.InLocation(article, outputLocation)))
+			})
+			if err != nil {
+				return err
+			}
+
+			return out.Flush()
+		},
+	}
+
+	cmd.Flags().IntVar(&authorID, "author-id", 0, "only export articles of this author")
+	cmd.Flags().IntVar(&batchSize, "batch-size", 1000, "number of articles fetched at a time")
+
+	return cmd
+}
+
+// articleRecord is an article as "articles export" writes it, with the
+// fields "articles import" reads.
+type articleRecord struct {
+	Title     string      `json:"title"`
+	Body      null.String `json:"body,omitempty"`
+	AuthorID  int         `json:"author_id"`
+	CreatedAt null.Time   `json:"created_at,omitempty"`
+}
+
+func newArticleRecord(article *dbmodels.Article) articleRecord {
+	return articleRecord{
+		Title:     article.Title,
+		Body:      article.Body,
+		AuthorID:  article.AuthorID,
+		CreatedAt: article.CreatedAt,
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestArticlesExportImport(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	db := boil.GetContextDB()
+	defer boil.SetDB(db)
+	boil.SetDB(conn)
+
+	created := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+	published := created.Add(time.Hour)
+
+	// The export holds every column of the articles, the ones managed by
+	// the database included.
+	mock.ExpectBegin()
+	mock.ExpectExec(`DECLARE article_cursor_\d+ NO SCROLL CURSOR FOR SELECT "article"\.\* FROM "article"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`FETCH FORWARD 10 FROM article_cursor_\d+`).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "title", "body", "created_at", "author_id", "deleted_at", "version", "updated_at", "status", "published_at"}).
+			AddRow(1, "First", "Hello", created, 1, nil, 3, published, dbmodels.ArticleStatusPublished, published).
+			AddRow(2, "Second", nil, nil, 2, nil, 1, created, dbmodels.ArticleStatusDraft, nil),
+	)
+	mock.ExpectExec(`CLOSE article_cursor_\d+`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	var exported bytes.Buffer
+	export := newArticlesExportCmd()
+	export.SetArgs([]string{"--batch-size", "10"})
+	export.SetOut(&exported)
+	if err := export.ExecuteContext(context.Background()); err != nil {
+		t.Fatalf("export = %v", err)
+	}
+
+	mock.ExpectBegin()
+	copyIn := mock.ExpectPrepare(`COPY "article" \("title", "body", "created_at", "author_id", "deleted_at", "published_at"\) FROM STDIN`)
+	copyIn.ExpectExec().WithArgs("First", null.StringFrom("Hello"), null.TimeFrom(created), 1, null.Time{}, null.Time{}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// Like Insert, the copy sets a missing created_at to now.
+	copyIn.ExpectExec().WithArgs("Second", null.String{}, sqlmock.AnyArg(), 2, null.Time{}, null.Time{}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	copyIn.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	var imported bytes.Buffer
+	importCmd := newArticlesImportCmd()
+	importCmd.SetArgs([]string{"--no-hooks", "--progress", "0", "-"})
+	importCmd.SetIn(&exported)
+	importCmd.SetOut(&imported)
+	if err := importCmd.ExecuteContext(context.Background()); err != nil {
+		t.Fatalf("import = %v", err)
+	}
+	if got, want := imported.String(), "imported 2 articles\n"; got != want {
+		t.Errorf("import wrote %q, want %q", got, want)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
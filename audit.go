@@ -0,0 +1,54 @@
+package main
+
+import (
+	"github.com/gurleensethi/go-sql-boiler-example/db/filter"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func newAuditCmd() *cobra.Command {
+	var limit, offset int
+	var filters filterFlags
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Browse the audit log of changes to authors and articles",
+		Long: `List who inserted, updated, upserted or deleted which author or article,
+newest first. Filter with --where on actor, operation, table_name, row_id
+and created_at, e.g. --where table_name=article --where row_id=1 for the
+history of an article. -o json shows the rows before and after every
+change.
+
+Changes are recorded with the --actor of the command that made them, or
+the X-Actor header of API requests.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filters.sort == "" {
+				filters.sort = "-" + dbmodels.AuditLogColumns.ID
+			}
+			q, err := filters.query(filter.AuditLog)
+			if err != nil {
+				return err
+			}
+
+			mods := append(q.Mods(), qm.Limit(limit), qm.Offset(offset))
+			entries, err := dbmodels.AuditLogs(mods...).AllG(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			t, err := auditTable(entries...)
+			if err != nil {
+				return err
+			}
+			return printResult(cmd, entries, t)
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 50, "maximum number of entries to list")
+	cmd.Flags().IntVar(&offset, "offset", 0, "number of entries to skip")
+	filters.registerQuery(cmd.Flags())
+
+	return cmd
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db/filter"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/repository"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func newAuthorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "authors",
+		Aliases: []string{"author"},
+		Short:   "Manage authors",
+	}
+
+	cmd.AddCommand(
+		newAuthorsCreateCmd(),
+		newAuthorsListCmd(),
+		newAuthorsGetCmd(),
+		newAuthorsDeleteCmd(),
+		newAuthorsRestoreCmd(),
+		newAuthorsImportCmd(),
+	)
+
+	return cmd
+}
+
+func newAuthorsCreateCmd() *cobra.Command {
+	var author dbmodels.Author
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an author",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := repos().Authors.Insert(cmd.Context(), &author); err != nil {
+				return err
+			}
+
+			return printResult(cmd, author, authorTable(&author))
+		},
+	}
+
+	cmd.Flags().StringVar(&author.Name, "name", "", "name of the author")
+	cmd.Flags().StringVar(&author.Email, "email", "", "email of the author")
+	cmd.MarkFlagRequired("name")
+	cmd.MarkFlagRequired("email")
+
+	return cmd
+}
+
+func newAuthorsListCmd() *cobra.Command {
+	var limit, offset int
+	var name, email string
+	var filters filterFlags
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List authors",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			q, err := filters.query(filter.Authors)
+			if err != nil {
+				return err
+			}
+			deleted, err := filters.deletedScope()
+			if err != nil {
+				return err
+			}
+
+			authors, err := repos().Authors.List(cmd.Context(), repository.AuthorFilter{
+				Name:    name,
+				Email:   email,
+				Query:   q,
+				Deleted: deleted,
+				Limit:   limit,
+				Offset:  offset,
+			})
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, authors, authorTable(authors...))
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "only list authors with this name")
+	cmd.Flags().StringVar(&email, "email", "", "only list authors with this email")
+	cmd.Flags().IntVar(&limit, "limit", 50, "maximum number of authors to list")
+	cmd.Flags().IntVar(&offset, "offset", 0, "number of authors to skip")
+	filters.register(cmd.Flags())
+
+	return cmd
+}
+
+func newAuthorsGetCmd() *cobra.Command {
+	var withArticles bool
+
+	cmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show an author",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			find := repos().Authors.Find
+			if withArticles {
+				find = repos().Authors.FindWithArticles
+			}
+
+			author, err := find(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			if !withArticles {
+				return printResult(cmd, author, authorTable(author))
+			}
+
+			// The relationship struct is not serialized by default, so
+			// nest the articles explicitly.
+			result := struct {
+				*dbmodels.Author
+				Articles dbmodels.ArticleSlice `json:"articles"`
+			}{author, author.R.GetArticles()}
+
+			if err := printResult(cmd, result, authorTable(author)); err != nil {
+				return err
+			}
+			if format, _ := cmd.Flags().GetString("output"); format == formatTable {
+				fmt.Fprintln(cmd.OutOrStdout())
+				return printTable(cmd.OutOrStdout(), articleTable(result.Articles...))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&withArticles, "articles", false, "also show the author's articles")
+
+	return cmd
+}
+
+func newAuthorsDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete an author",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			author, err := repos().Authors.Find(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			if err := repos().Authors.Delete(cmd.Context(), id); err != nil {
+				return err
+			}
+
+			return printResult(cmd, author, authorTable(author))
+		},
+	}
+}
+
+func newAuthorsRestoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <id>",
+		Short: "Restore a deleted author",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			author, err := repos().Authors.Restore(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, author, authorTable(author))
+		},
+	}
+}
+
+func parseID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid id %q", s)
+	}
+	return id, nil
+}
+
+func newAuthorsImportCmd() *cobra.Command {
+	var flags importFlags
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Bulk load authors from a file of JSON objects",
+		Long: `Bulk load authors from a file with one JSON object per author, such as
+JSON lines, using COPY. Objects have the fields name and email.
+Use - to read from stdin. Nothing is imported if any author fails.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in, err := openInput(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			dec := jsonDecoder(in)
+			n, err := dbmodels.CopyAuthors(cmd.Context(), boil.GetContextDB(), func() (*dbmodels.Author, error) {
+				var author dbmodels.Author
+				if err := dec.Decode(&author); err != nil {
+					return nil, err
+				}
+				return &author, nil
+			}, flags.options(cmd, "authors")...)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "imported %d authors\n", n)
+			return nil
+		},
+	}
+
+	flags.register(cmd.Flags())
+
+	return cmd
+}
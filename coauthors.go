@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func newArticlesAuthorsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "authors <id> [author-id[:role]]...",
+		Short: "Show or set the authors of an article",
+		Long: `Show the authors credited on an article. With authors, the article's
+credits are replaced with them first, in the given order. Roles are
+primary, contributor and editor; an author without a role is the primary
+author if it comes first and a contributor otherwise. The primary author
+is also the article's --author-id.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var credits dbmodels.ArticleAuthorSlice
+			for i, arg := range args[1:] {
+				credit, err := parseCredit(arg, i == 0)
+				if err != nil {
+					return err
+				}
+				credits = append(credits, credit)
+			}
+
+			err = db.WithTx(cmd.Context(), boil.GetContextDB(), func(tx boil.ContextExecutor) error {
+				credits, err = articleCredits(cmd.Context(), tx, id, credits)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, credits, creditTable(credits...))
+		},
+	}
+}
+
+// parseCredit parses an author id with an optional role, defaulting to
+// primary for the first credit and to contributor for the others.
+func parseCredit(s string, first bool) (*dbmodels.ArticleAuthor, error) {
+	idPart, role, hasRole := strings.Cut(s, ":")
+
+	id, err := parseID(idPart)
+	if err != nil {
+		return nil, err
+	}
+
+	credit := &dbmodels.ArticleAuthor{AuthorID: id, Role: dbmodels.ArticleAuthorRoleContributor}
+	if first {
+		credit.Role = dbmodels.ArticleAuthorRolePrimary
+	}
+	if hasRole {
+		credit.Role = dbmodels.ArticleAuthorRole(role)
+		if credit.Role.IsValid() != nil {
+			return nil, fmt.Errorf("invalid role %q of author %d, use one of %v", role, id, dbmodels.AllArticleAuthorRole())
+		}
+	}
+
+	return credit, nil
+}
+
+// articleCredits replaces the credits of the article with the given id
+// unless credits is empty and returns its credits.
+func articleCredits(ctx context.Context, tx boil.ContextExecutor, id int, credits dbmodels.ArticleAuthorSlice) (dbmodels.ArticleAuthorSlice, error) {
+	article, err := dbmodels.FindArticle(ctx, tx, id)
+	if err != nil {
+		return nil, db.Translate(err, dbmodels.TableNames.Article)
+	}
+
+	if len(credits) > 0 {
+		if err := article.SetAuthors(ctx, tx, credits...); err != nil {
+			return nil, db.Translate(err, dbmodels.TableNames.ArticleAuthor)
+		}
+	}
+
+	return article.Credits().All(ctx, tx)
+}
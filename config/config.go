@@ -0,0 +1,397 @@
+// Package config loads the application configuration from a config file,
+// environment variables and command line flags.
+//
+// Values are resolved with the following precedence, highest first:
+//
+//  1. command line flags, e.g. --database-max-open-conns=20
+//  2. environment variables, e.g. BLOG_DATABASE_MAX_OPEN_CONNS=20
+//  3. the config file, e.g. max_open_conns = 20 in the [database] table
+//  4. built-in defaults
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// EnvPrefix is prepended to every environment variable read by Load.
+const EnvPrefix = "BLOG"
+
+// Config is the complete application configuration.
+type Config struct {
+	Database   Database   `mapstructure:"database"`
+	Pagination Pagination `mapstructure:"pagination"`
+	Search     Search     `mapstructure:"search"`
+	Server     Server     `mapstructure:"server"`
+	Time       Time       `mapstructure:"time"`
+}
+
+// Database configures the connection pool to Postgres.
+type Database struct {
+	// DSN is a complete connection string. When set it takes precedence
+	// over Host, Port, User, Password and Name.
+	DSN      string `mapstructure:"dsn"`
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	User     string `mapstructure:"user"`
+	Password string `mapstructure:"password"`
+	Name     string `mapstructure:"name"`
+	SSLMode  string `mapstructure:"sslmode"`
+
+	MaxOpenConns    int           `mapstructure:"max_open_conns"`
+	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"`
+
+	// StatementTimeout aborts any statement that runs longer than this.
+	// Zero disables the timeout.
+	StatementTimeout time.Duration `mapstructure:"statement_timeout"`
+
+	// ConnectRetries is the number of times the startup ping is retried
+	// before giving up. The wait between attempts starts at ConnectBackoff
+	// and doubles after every failure.
+	ConnectRetries int           `mapstructure:"connect_retries"`
+	ConnectBackoff time.Duration `mapstructure:"connect_backoff"`
+
+	// CheckSchema compares the database against the generated models at
+	// startup and refuses to run when they differ.
+	CheckSchema bool `mapstructure:"check_schema"`
+}
+
+// Pagination configures the cursors handed out for paged lists.
+type Pagination struct {
+	// Secret signs the cursors. When empty a random secret is used, so
+	// cursors stop working when the process restarts and aren't accepted
+	// by other instances.
+	Secret string `mapstructure:"secret"`
+}
+
+// Search configures full-text search over articles.
+type Search struct {
+	// Language is the text search configuration queries are parsed with.
+	// Only the one article_search_idx is built with, english, can use the
+	// index.
+	Language string `mapstructure:"language"`
+}
+
+// Server configures the REST API.
+type Server struct {
+	// Editors maps the names of editors to the tokens they authenticate
+	// API requests with. Changes are recorded in the audit log with the
+	// name of the editor making them. It is only read from the config
+	// file, names are lowercase.
+	Editors map[string]string `mapstructure:"editors"`
+}
+
+// Time configures the time zone of timestamps.
+type Time struct {
+	// Location is the IANA name of the time zone, such as "Europe/Berlin",
+	// the models set timestamps in and output shows them in unless another
+	// one is asked for. "Local" is the zone of the machine.
+	Location string `mapstructure:"location"`
+}
+
+// LoadLocation returns the time zone named by Location.
+func (t Time) LoadLocation() (*time.Location, error) {
+	loc, err := time.LoadLocation(t.Location)
+	if err != nil {
+		return nil, fmt.Errorf("config: invalid time.location %q: %w", t.Location, err)
+	}
+	return loc, nil
+}
+
+// Default returns the configuration used when nothing else is set. It
+// matches the Postgres container from docker-compose.yaml.
+func Default() Config {
+	return Config{
+		Database: Database{
+			Host:     "localhost",
+			Port:     2345,
+			User:     "postgres",
+			Password: "postgres",
+			Name:     "postgres",
+			SSLMode:  "disable",
+
+			MaxOpenConns:    10,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 30 * time.Minute,
+			ConnMaxIdleTime: 5 * time.Minute,
+
+			ConnectRetries: 5,
+			ConnectBackoff: 500 * time.Millisecond,
+		},
+		Search: Search{
+			Language: "english",
+		},
+		Time: Time{
+			Location: "UTC",
+		},
+	}
+}
+
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+// Validate reports the first invalid setting in c.
+func (c Config) Validate() error {
+	db := c.Database
+
+	if db.DSN == "" && db.Host == "" {
+		return errors.New("config: database.host or database.dsn must be set")
+	}
+	if db.SSLMode != "" && !contains(sslModes, db.SSLMode) {
+		return fmt.Errorf("config: invalid database.sslmode %q, must be one of %s", db.SSLMode, strings.Join(sslModes, ", "))
+	}
+	if db.MaxOpenConns < 0 || db.MaxIdleConns < 0 {
+		return errors.New("config: database connection limits must not be negative")
+	}
+	if db.ConnectRetries < 0 {
+		return errors.New("config: database.connect_retries must not be negative")
+	}
+	if db.ConnectBackoff < 0 {
+		return errors.New("config: database.connect_backoff must not be negative")
+	}
+	if db.StatementTimeout < 0 {
+		return errors.New("config: database.statement_timeout must not be negative")
+	}
+	if _, err := c.Time.LoadLocation(); err != nil {
+		return err
+	}
+	if err := c.Server.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// flagKeys maps the flags registered by RegisterFlags to their config key.
+var flagKeys = map[string]string{
+	"database-dsn":                "database.dsn",
+	"database-host":               "database.host",
+	"database-port":               "database.port",
+	"database-user":               "database.user",
+	"database-password":           "database.password",
+	"database-name":               "database.name",
+	"database-sslmode":            "database.sslmode",
+	"database-max-open-conns":     "database.max_open_conns",
+	"database-max-idle-conns":     "database.max_idle_conns",
+	"database-conn-max-lifetime":  "database.conn_max_lifetime",
+	"database-conn-max-idle-time": "database.conn_max_idle_time",
+	"database-statement-timeout":  "database.statement_timeout",
+	"database-connect-retries":    "database.connect_retries",
+	"database-connect-backoff":    "database.connect_backoff",
+	"database-check-schema":       "database.check_schema",
+	"pagination-secret":           "pagination.secret",
+	"search-language":             "search.language",
+	"time-location":               "time.location",
+}
+
+// RegisterFlags adds a flag for every config key to fs. The flags default to
+// the values from Default.
+func RegisterFlags(fs *pflag.FlagSet) {
+	d := Default().Database
+	search := Default().Search
+	tm := Default().Time
+
+	fs.String("config", "", "path to a config file (default ./config.{toml,yaml,json} if present)")
+
+	fs.String("database-dsn", d.DSN, "Postgres connection string, overrides the other connection flags")
+	fs.String("database-host", d.Host, "Postgres host")
+	fs.Int("database-port", d.Port, "Postgres port")
+	fs.String("database-user", d.User, "Postgres user")
+	fs.String("database-password", d.Password, "Postgres password")
+	fs.String("database-name", d.Name, "Postgres database name")
+	fs.String("database-sslmode", d.SSLMode, "Postgres SSL mode ("+strings.Join(sslModes, ", ")+")")
+	fs.Int("database-max-open-conns", d.MaxOpenConns, "maximum number of open connections, 0 is unlimited")
+	fs.Int("database-max-idle-conns", d.MaxIdleConns, "maximum number of idle connections")
+	fs.Duration("database-conn-max-lifetime", d.ConnMaxLifetime, "maximum time a connection may be reused, 0 is forever")
+	fs.Duration("database-conn-max-idle-time", d.ConnMaxIdleTime, "maximum time a connection may be idle, 0 is forever")
+	fs.Duration("database-statement-timeout", d.StatementTimeout, "abort statements running longer than this, 0 disables the timeout")
+	fs.Int("database-connect-retries", d.ConnectRetries, "number of times to retry the startup ping")
+	fs.Duration("database-connect-backoff", d.ConnectBackoff, "initial wait between startup ping retries")
+	fs.Bool("database-check-schema", d.CheckSchema, "check at startup that the database matches the generated models")
+	fs.String("pagination-secret", "", "secret signing page cursors, random if empty")
+	fs.String("search-language", search.Language, "text search configuration for full-text search")
+	fs.String("time-location", tm.Location, "time zone timestamps are set and shown in, e.g. Europe/Berlin or Local")
+}
+
+// Load resolves the configuration from the config file, the environment and
+// the flags in fs. fs is expected to contain the flags added by
+// RegisterFlags, unknown flags are ignored.
+func Load(fs *pflag.FlagSet) (Config, error) {
+	v := viper.New()
+
+	setDefaults(v, Default())
+
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	for name, key := range flagKeys {
+		if f := fs.Lookup(name); f != nil {
+			if err := v.BindPFlag(key, f); err != nil {
+				return Config{}, err
+			}
+		}
+	}
+
+	if err := readConfigFile(v, fs); err != nil {
+		return Config{}, err
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return Config{}, fmt.Errorf("config: %w", err)
+	}
+
+	return cfg, cfg.Validate()
+}
+
+func readConfigFile(v *viper.Viper, fs *pflag.FlagSet) error {
+	path, _ := fs.GetString("config")
+	if path == "" {
+		path = v.GetString("config")
+	}
+
+	if path != "" {
+		v.SetConfigFile(path)
+	} else {
+		v.SetConfigName("config")
+		v.AddConfigPath(".")
+	}
+
+	err := v.ReadInConfig()
+	if err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if path == "" && errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("config: %w", err)
+	}
+
+	return nil
+}
+
+// setDefaults registers every key of cfg with v. Viper only considers
+// environment variables for keys it knows about, so this is required for
+// AutomaticEnv to work with Unmarshal.
+func setDefaults(v *viper.Viper, cfg Config) {
+	db := cfg.Database
+
+	v.SetDefault("database.dsn", db.DSN)
+	v.SetDefault("database.host", db.Host)
+	v.SetDefault("database.port", db.Port)
+	v.SetDefault("database.user", db.User)
+	v.SetDefault("database.password", db.Password)
+	v.SetDefault("database.name", db.Name)
+	v.SetDefault("database.sslmode", db.SSLMode)
+	v.SetDefault("database.max_open_conns", db.MaxOpenConns)
+	v.SetDefault("database.max_idle_conns", db.MaxIdleConns)
+	v.SetDefault("database.conn_max_lifetime", db.ConnMaxLifetime)
+	v.SetDefault("database.conn_max_idle_time", db.ConnMaxIdleTime)
+	v.SetDefault("database.statement_timeout", db.StatementTimeout)
+	v.SetDefault("database.connect_retries", db.ConnectRetries)
+	v.SetDefault("database.connect_backoff", db.ConnectBackoff)
+	v.SetDefault("database.check_schema", db.CheckSchema)
+	v.SetDefault("pagination.secret", cfg.Pagination.Secret)
+	v.SetDefault("search.language", cfg.Search.Language)
+	v.SetDefault("time.location", cfg.Time.Location)
+}
+
+// validate reports editors without a token and tokens shared by several
+// editors, which would make the one authenticating ambiguous.
+func (s Server) validate() error {
+	names := make([]string, 0, len(s.Editors))
+	for name := range s.Editors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	seen := map[string]string{}
+	for _, name := range names {
+		token := s.Editors[name]
+		if token == "" {
+			return fmt.Errorf("config: server.editors.%s has no token", name)
+		}
+		if other, ok := seen[token]; ok {
+			return fmt.Errorf("config: server.editors.%s and server.editors.%s have the same token", other, name)
+		}
+		seen[token] = name
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// ConnString returns the connection string for lib/pq. DSN is used as the
+// base when set, in either URL or key=value form. SSLMode is only applied
+// when the DSN doesn't choose one itself, StatementTimeout always is.
+func (d Database) ConnString() (string, error) {
+	params := map[string]string{}
+	if d.SSLMode != "" {
+		params["sslmode"] = d.SSLMode
+	}
+	if d.StatementTimeout > 0 {
+		params["statement_timeout"] = strconv.FormatInt(d.StatementTimeout.Milliseconds(), 10)
+	}
+
+	if d.DSN == "" {
+		u := url.URL{
+			Scheme: "postgres",
+			User:   url.UserPassword(d.User, d.Password),
+			Host:   net.JoinHostPort(d.Host, strconv.Itoa(d.Port)),
+			Path:   "/" + d.Name,
+		}
+		q := url.Values{}
+		for k, v := range params {
+			q.Set(k, v)
+		}
+		u.RawQuery = q.Encode()
+		return u.String(), nil
+	}
+
+	if strings.HasPrefix(d.DSN, "postgres://") || strings.HasPrefix(d.DSN, "postgresql://") {
+		u, err := url.Parse(d.DSN)
+		if err != nil {
+			return "", fmt.Errorf("config: invalid database.dsn: %w", err)
+		}
+		q := u.Query()
+		for k, v := range params {
+			if k == "sslmode" && q.Has(k) {
+				continue
+			}
+			q.Set(k, v)
+		}
+		u.RawQuery = q.Encode()
+		return u.String(), nil
+	}
+
+	dsn := d.DSN
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if k == "sslmode" && strings.Contains(dsn, "sslmode=") {
+			continue
+		}
+		dsn += " " + k + "=" + params[k]
+	}
+	return dsn, nil
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		err    string
+	}{
+		{"default", func(c *Config) {}, ""},
+		{"dsn without host", func(c *Config) { c.Database.Host, c.Database.DSN = "", "postgres://localhost" }, ""},
+		{"no host", func(c *Config) { c.Database.Host = "" }, "database.host or database.dsn"},
+		{"sslmode", func(c *Config) { c.Database.SSLMode = "maybe" }, "database.sslmode"},
+		{"max open conns", func(c *Config) { c.Database.MaxOpenConns = -1 }, "connection limits"},
+		{"connect retries", func(c *Config) { c.Database.ConnectRetries = -1 }, "connect_retries"},
+		{"connect backoff", func(c *Config) { c.Database.ConnectBackoff = -time.Second }, "connect_backoff"},
+		{"zero connect backoff", func(c *Config) { c.Database.ConnectBackoff = 0 }, ""},
+		{"statement timeout", func(c *Config) { c.Database.StatementTimeout = -time.Second }, "statement_timeout"},
+		{"editors", func(c *Config) { c.Server.Editors = map[string]string{"alice": "a", "bob": "b"} }, ""},
+		{"editor without token", func(c *Config) { c.Server.Editors = map[string]string{"alice": ""} }, "server.editors.alice"},
+		{"shared token", func(c *Config) { c.Server.Editors = map[string]string{"alice": "a", "bob": "a"} }, "server.editors.alice and server.editors.bob"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default()
+			tt.modify(&c)
+
+			err := c.Validate()
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatalf("Validate() = %v, want nil", err)
+			case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+				t.Fatalf("Validate() = %v, want an error mentioning %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Package db opens and manages the Postgres connection pool shared by the
+// generated models in db/models.
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/config"
+	_ "github.com/lib/pq"
+)
+
+// maxBackoff caps the wait between two startup ping attempts.
+const maxBackoff = 30 * time.Second
+
+// Open opens a connection pool configured from cfg and pings the database
+// until it responds, retrying with exponential backoff as configured by
+// cfg.ConnectRetries and cfg.ConnectBackoff.
+func Open(ctx context.Context, cfg config.Database) (*sql.DB, error) {
+	dsn, err := cfg.ConnString()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("db: unable to open connection: %w", err)
+	}
+
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	if err := ping(ctx, db, cfg.ConnectRetries, cfg.ConnectBackoff); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func ping(ctx context.Context, db *sql.DB, retries int, backoff time.Duration) error {
+	for attempt := 0; ; attempt++ {
+		err := db.PingContext(ctx)
+		if err == nil {
+			return nil
+		}
+		if attempt >= retries {
+			return fmt.Errorf("db: unable to reach database after %d attempts: %w", attempt+1, err)
+		}
+
+		log.Printf("db: ping failed (attempt %d of %d), retrying in %s: %v", attempt+1, retries+1, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
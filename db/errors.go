@@ -0,0 +1,143 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// Postgres error codes for constraint violations, see
+// https://www.postgresql.org/docs/current/errcodes-appendix.html.
+const (
+	pqNotNullViolation    pq.ErrorCode = "23502"
+	pqForeignKeyViolation pq.ErrorCode = "23503"
+	pqUniqueViolation     pq.ErrorCode = "23505"
+)
+
+// Kinds of errors returned by Translate. Match them with errors.Is, and use
+// errors.As with *Error for the table, column and constraint involved.
+var (
+	// ErrNotFound is returned when a row doesn't exist.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when a write violates a unique constraint.
+	ErrConflict = errors.New("conflict")
+	// ErrReferenced is returned when deleting a row that other rows still
+	// reference, e.g. an author that still has articles.
+	ErrReferenced = errors.New("still referenced")
+	// ErrInvalidReference is returned when a row references a row that
+	// doesn't exist, e.g. an article with a nonexistent author_id.
+	ErrInvalidReference = errors.New("invalid reference")
+	// ErrRequired is returned when a NOT NULL column is written as NULL.
+	ErrRequired = errors.New("required")
+)
+
+// Error is a database error translated into one of the kinds above.
+type Error struct {
+	// Kind is one of the Err* variables of this package.
+	Kind error
+	// Table, Column and Constraint name the schema objects involved, as
+	// far as they are known.
+	Table      string
+	Column     string
+	Constraint string
+	// Err is the original error.
+	Err error
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+
+	if e.Table != "" {
+		b.WriteString(e.Table)
+		if e.Column != "" {
+			b.WriteByte('.')
+			b.WriteString(e.Column)
+		}
+		b.WriteString(": ")
+	}
+	b.WriteString(e.Kind.Error())
+	if e.Constraint != "" {
+		b.WriteString(" (")
+		b.WriteString(e.Constraint)
+		b.WriteByte(')')
+	}
+
+	return b.String()
+}
+
+// Is makes errors.Is match the kind of e.
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Postgres only reports the offending column of a foreign key violation in
+// the detail message, e.g. `Key (author_id)=(42) is not present in table
+// "author".` The words are translated with lc_messages, so only the
+// parentheses are matched.
+var detailKeyColumn = regexp.MustCompile(`^[^(]*\(([^)]+)\)=\(`)
+
+// Translate turns sql.ErrNoRows and Postgres constraint violations anywhere
+// in err's chain into an *Error. table is the table that was read or
+// written; it is reported for errors that don't name a table themselves,
+// such as sql.ErrNoRows, and tells foreign key violations of the
+// referencing and the referenced side apart. Other errors, including nil,
+// are returned unchanged.
+func Translate(err error, table string) error {
+	if err == nil {
+		return nil
+	}
+
+	var dbErr *Error
+	if errors.As(err, &dbErr) {
+		return err
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return &Error{Kind: ErrNotFound, Table: table, Err: err}
+	}
+
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return err
+	}
+
+	translated := &Error{
+		Table:      pqErr.Table,
+		Column:     pqErr.Column,
+		Constraint: pqErr.Constraint,
+		Err:        err,
+	}
+	if translated.Table == "" {
+		translated.Table = table
+	}
+
+	switch pqErr.Code {
+	case pqUniqueViolation:
+		translated.Kind = ErrConflict
+	case pqNotNullViolation:
+		translated.Kind = ErrRequired
+	case pqForeignKeyViolation:
+		if m := detailKeyColumn.FindStringSubmatch(pqErr.Detail); m != nil {
+			translated.Column = m[1]
+		}
+		// The violation is reported on the referencing table either way,
+		// so it was the referenced row that was being written if that
+		// isn't the table written to.
+		if pqErr.Table != "" && pqErr.Table != table {
+			translated.Kind = ErrReferenced
+		} else {
+			translated.Kind = ErrInvalidReference
+		}
+	default:
+		return err
+	}
+
+	return translated
+}
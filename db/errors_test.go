@@ -0,0 +1,98 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		table string
+		want  *Error
+	}{
+		{
+			name:  "no rows",
+			err:   fmt.Errorf("find: %w", sql.ErrNoRows),
+			table: "author",
+			want:  &Error{Kind: ErrNotFound, Table: "author"},
+		},
+		{
+			name: "unique",
+			err: &pq.Error{Code: pqUniqueViolation, Table: "author", Constraint: "author_email_key",
+				Detail: `Key (email)=(a@example.com) already exists.`},
+			table: "author",
+			want:  &Error{Kind: ErrConflict, Table: "author", Constraint: "author_email_key"},
+		},
+		{
+			name:  "not null",
+			err:   &pq.Error{Code: pqNotNullViolation, Table: "article", Column: "title"},
+			table: "article",
+			want:  &Error{Kind: ErrRequired, Table: "article", Column: "title"},
+		},
+		{
+			name: "invalid reference",
+			err: &pq.Error{Code: pqForeignKeyViolation, Table: "article", Constraint: "fk_author_id",
+				Detail: `Key (author_id)=(42) is not present in table "author".`},
+			table: "article",
+			want:  &Error{Kind: ErrInvalidReference, Table: "article", Column: "author_id", Constraint: "fk_author_id"},
+		},
+		{
+			name: "still referenced",
+			err: &pq.Error{Code: pqForeignKeyViolation, Table: "article", Constraint: "fk_author_id",
+				Detail: `Key (id)=(42) is still referenced from table "article".`},
+			table: "author",
+			want:  &Error{Kind: ErrReferenced, Table: "article", Column: "id", Constraint: "fk_author_id"},
+		},
+		{
+			name: "invalid reference in german",
+			err: &pq.Error{Code: pqForeignKeyViolation, Table: "article", Constraint: "fk_author_id",
+				Detail: `Schlüssel (author_id)=(42) ist nicht in Tabelle »author« vorhanden.`},
+			table: "article",
+			want:  &Error{Kind: ErrInvalidReference, Table: "article", Column: "author_id", Constraint: "fk_author_id"},
+		},
+		{
+			name: "still referenced in german",
+			err: &pq.Error{Code: pqForeignKeyViolation, Table: "article", Constraint: "fk_author_id",
+				Detail: `Auf Schlüssel (id)=(42) wird noch aus Tabelle »article« verwiesen.`},
+			table: "author",
+			want:  &Error{Kind: ErrReferenced, Table: "article", Column: "id", Constraint: "fk_author_id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Translate(tt.err, tt.table)
+
+			var dbErr *Error
+			if !errors.As(got, &dbErr) {
+				t.Fatalf("Translate() = %v, want an *Error", got)
+			}
+			if dbErr.Kind != tt.want.Kind || dbErr.Table != tt.want.Table || dbErr.Column != tt.want.Column || dbErr.Constraint != tt.want.Constraint {
+				t.Errorf("Translate() = %+v, want %+v", dbErr, tt.want)
+			}
+			if !errors.Is(got, tt.want.Kind) || !errors.Is(got, tt.err) {
+				t.Errorf("Translate() = %v doesn't match its kind and the original error", got)
+			}
+		})
+	}
+}
+
+func TestTranslateUnchanged(t *testing.T) {
+	other := errors.New("other")
+	if got := Translate(other, "author"); got != other {
+		t.Errorf("Translate(other) = %v", got)
+	}
+	if got := Translate(nil, "author"); got != nil {
+		t.Errorf("Translate(nil) = %v", got)
+	}
+	syntax := &pq.Error{Code: "42601"}
+	if got := Translate(syntax, "author"); got != syntax {
+		t.Errorf("Translate(syntax error) = %v", got)
+	}
+}
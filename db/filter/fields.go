@@ -0,0 +1,223 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// comparisons is implemented by the generated where helpers of columns
+// holding a T, such as dbmodels.ArticleWhere.Title.
+type comparisons[T any] interface {
+	EQ(x T) qm.QueryMod
+	NEQ(x T) qm.QueryMod
+	LT(x T) qm.QueryMod
+	LTE(x T) qm.QueryMod
+	GT(x T) qm.QueryMod
+	GTE(x T) qm.QueryMod
+}
+
+type intWhere interface {
+	comparisons[int]
+	IN(slice []int) qm.QueryMod
+	NIN(slice []int) qm.QueryMod
+}
+
+type stringWhere interface {
+	comparisons[string]
+	IN(slice []string) qm.QueryMod
+	NIN(slice []string) qm.QueryMod
+}
+
+type enumWhere[T ~string] interface {
+	EQ(x T) qm.QueryMod
+	NEQ(x T) qm.QueryMod
+	IN(slice []T) qm.QueryMod
+	NIN(slice []T) qm.QueryMod
+}
+
+type nullWhere[T any] interface {
+	comparisons[T]
+	IsNull() qm.QueryMod
+	IsNotNull() qm.QueryMod
+}
+
+// comparisonsAnd returns the comparison operators followed by ops.
+func comparisonsAnd(ops ...string) []string {
+	return append([]string{OpEq, OpNe, OpLt, OpLte, OpGt, OpGte}, ops...)
+}
+
+// Int returns the Field of an integer column filtered with the where
+// helper w, e.g. dbmodels.ArticleWhere.AuthorID.
+func Int(column string, w intWhere) Field {
+	return Field{
+		column: column,
+		ops:    comparisonsAnd(OpIn, OpNin),
+		parse: func(s string) (interface{}, error) {
+			i, err := strconv.Atoi(s)
+			if err != nil {
+				return nil, errors.New("must be an integer")
+			}
+			return i, nil
+		},
+		where: func(op string, values []interface{}) qm.QueryMod {
+			switch op {
+			case OpIn:
+				return w.IN(typed[int](values))
+			case OpNin:
+				return w.NIN(typed[int](values))
+			}
+			return where[int](w, op, values[0].(int))
+		},
+	}
+}
+
+// String returns the Field of a text column filtered with the where helper
+// w, e.g. dbmodels.ArticleWhere.Title.
+func String(column string, w stringWhere) Field {
+	return Field{
+		column: column,
+		ops:    comparisonsAnd(OpIn, OpNin, OpLike),
+		parse: func(s string) (interface{}, error) {
+			return s, nil
+		},
+		where: func(op string, values []interface{}) qm.QueryMod {
+			switch op {
+			case OpIn:
+				return w.IN(typed[string](values))
+			case OpNin:
+				return w.NIN(typed[string](values))
+			}
+			return where[string](w, op, values[0].(string))
+		},
+	}
+}
+
+// Enum returns the Field of an enum column filtered with the where helper
+// w, e.g. dbmodels.ArticleWhere.Status, that accepts the allowed values.
+// Enums sort in the order of their values in Postgres, not by name, so
+// they are only compared for equality.
+func Enum[T ~string](column string, w enumWhere[T], allowed []T) Field {
+	return Field{
+		column: column,
+		ops:    []string{OpEq, OpNe, OpIn, OpNin},
+		parse: func(s string) (interface{}, error) {
+			for _, v := range allowed {
+				if string(v) == s {
+					return v, nil
+				}
+			}
+			return nil, fmt.Errorf("must be one of %v", allowed)
+		},
+		where: func(op string, values []interface{}) qm.QueryMod {
+			switch op {
+			case OpIn:
+				return w.IN(typed[T](values))
+			case OpNin:
+				return w.NIN(typed[T](values))
+			case OpNe:
+				return w.NEQ(values[0].(T))
+			}
+			return w.EQ(values[0].(T))
+		},
+	}
+}
+
+// NullString returns the Field of a nullable text column filtered with the
+// where helper w, e.g. dbmodels.ArticleWhere.Body.
+func NullString(column string, w nullWhere[null.String]) Field {
+	return Field{
+		column: column,
+		ops:    comparisonsAnd(OpLike, OpNull),
+		parse: func(s string) (interface{}, error) {
+			return null.StringFrom(s), nil
+		},
+		where: nullable[null.String](w),
+	}
+}
+
+// Time returns the Field of a timestamp column filtered with the where
+// helper w, e.g. dbmodels.ArticleWhere.UpdatedAt. Values are RFC 3339
+// timestamps or dates, which are midnight in boil.GetLocation.
+func Time(column string, w comparisons[time.Time]) Field {
+	return Field{
+		column: column,
+		ops:    comparisonsAnd(),
+		parse: func(s string) (interface{}, error) {
+			return parseTime(s)
+		},
+		where: func(op string, values []interface{}) qm.QueryMod {
+			return where[time.Time](w, op, values[0].(time.Time))
+		},
+	}
+}
+
+// NullTime returns the Field of a nullable timestamp column filtered with
+// the where helper w, e.g. dbmodels.ArticleWhere.CreatedAt. Values are
+// parsed like for Time.
+func NullTime(column string, w nullWhere[null.Time]) Field {
+	return Field{
+		column: column,
+		ops:    comparisonsAnd(OpNull),
+		parse: func(s string) (interface{}, error) {
+			t, err := parseTime(s)
+			if err != nil {
+				return nil, err
+			}
+			return null.TimeFrom(t), nil
+		},
+		where: nullable[null.Time](w),
+	}
+}
+
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, boil.GetLocation()); err == nil {
+		return t, nil
+	}
+	return time.Time{}, errors.New("must be an RFC 3339 timestamp or a date")
+}
+
+func nullable[T any](w nullWhere[T]) func(op string, values []interface{}) qm.QueryMod {
+	return func(op string, values []interface{}) qm.QueryMod {
+		if op == OpNull {
+			if values[0].(bool) {
+				return w.IsNull()
+			}
+			return w.IsNotNull()
+		}
+		return where[T](w, op, values[0].(T))
+	}
+}
+
+// where returns the where clause comparing the column of w with x.
+func where[T any](w comparisons[T], op string, x T) qm.QueryMod {
+	switch op {
+	case OpNe:
+		return w.NEQ(x)
+	case OpLt:
+		return w.LT(x)
+	case OpLte:
+		return w.LTE(x)
+	case OpGt:
+		return w.GT(x)
+	case OpGte:
+		return w.GTE(x)
+	}
+	return w.EQ(x)
+}
+
+func typed[T any](values []interface{}) []T {
+	s := make([]T, len(values))
+	for i, v := range values {
+		s[i] = v.(T)
+	}
+	return s
+}
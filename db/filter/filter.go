@@ -0,0 +1,445 @@
+// Package filter compiles filters and a sort order given as URL query
+// parameters into query mods, e.g.
+//
+//	title=like:Hello*&created_at=gte:2024-01-01&sort=-created_at
+//
+// Every parameter filters the column it is named after. The value is
+// prefixed with an operator, eq if there is none:
+//
+//	eq, ne, lt, lte, gt, gte  compare the column with the value
+//	in, nin                   check for membership in a comma separated list
+//	like                      match a pattern, * matches any characters
+//	null                      true or false checks for NULL
+//
+// A value whose prefix looks like an operator but isn't one is rejected,
+// values containing a colon can be written as eq:value. Parameters
+// repeated for the same column all have to match.
+//
+// sort orders by a column, prefixed with "-" for descending order, in the
+// same format as pagination.Params.Sort. NULLs sort last and ties are
+// broken by the id.
+//
+// Only the columns of a Schema can be filtered and sorted by, and only with
+// the operators their type supports.
+package filter
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Operators.
+const (
+	OpEq   = "eq"
+	OpNe   = "ne"
+	OpLt   = "lt"
+	OpLte  = "lte"
+	OpGt   = "gt"
+	OpGte  = "gte"
+	OpIn   = "in"
+	OpNin  = "nin"
+	OpLike = "like"
+	OpNull = "null"
+)
+
+// SortParam is the name of the parameter holding the sort order.
+const SortParam = "sort"
+
+var (
+	// ErrUnknownField is returned for parameters that aren't a column of
+	// the Schema.
+	ErrUnknownField = errors.New("unknown field")
+	// ErrInvalidOperator is returned for operators that don't exist or
+	// aren't supported by the type of the column.
+	ErrInvalidOperator = errors.New("invalid operator")
+	// ErrInvalidValue is returned for values that can't be parsed into the
+	// type of the column.
+	ErrInvalidValue = errors.New("invalid value")
+	// ErrNotSortable is returned when sorting by a column that can't be
+	// sorted by.
+	ErrNotSortable = errors.New("not sortable")
+)
+
+// Error describes a parameter that can't be compiled. Err is one of the
+// errors above.
+type Error struct {
+	Field string
+	// Message describes the problem for the client that sent the
+	// parameter.
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	return "filter: " + e.Field + ": " + e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Schema whitelists the columns of a table that can be filtered and sorted
+// by.
+type Schema struct {
+	table  string
+	id     string
+	fields map[string]Field
+}
+
+// NewSchema returns the Schema of table allowing fields. It panics if table
+// isn't one of dbmodels.Tables.
+func NewSchema(table string, fields ...Field) *Schema {
+	t, ok := dbmodels.LookupTable(table)
+	if !ok || len(t.PrimaryKey) != 1 {
+		panic(fmt.Sprintf("filter: %s isn't a table with a single column primary key", table))
+	}
+
+	s := &Schema{table: table, id: t.PrimaryKey[0], fields: map[string]Field{}}
+	for _, f := range fields {
+		s.fields[f.column] = f
+	}
+	return s
+}
+
+// Parse compiles values into a Query. Parameters named in ignore, such as
+// the ones selecting a page, are skipped.
+func (s *Schema) Parse(values url.Values, ignore ...string) (Query, error) {
+	q := Query{table: s.table, id: s.id}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if contains(ignore, key) {
+			continue
+		}
+
+		if key == SortParam {
+			if err := s.parseSort(&q, values[key]); err != nil {
+				return Query{}, err
+			}
+			continue
+		}
+
+		f, ok := s.fields[key]
+		if !ok {
+			return Query{}, &Error{Field: key, Message: "unknown field", Err: ErrUnknownField}
+		}
+		for _, value := range values[key] {
+			c, err := f.compile(s.table, value)
+			if err != nil {
+				return Query{}, err
+			}
+			q.conditions = append(q.conditions, c)
+		}
+	}
+
+	return q, nil
+}
+
+func (s *Schema) parseSort(q *Query, values []string) error {
+	if len(values) != 1 {
+		return &Error{Field: SortParam, Message: "must be given once", Err: ErrInvalidValue}
+	}
+
+	name := strings.TrimPrefix(values[0], "-")
+	if f, ok := s.fields[name]; name != s.id && (!ok || !f.sortable) {
+		return &Error{Field: SortParam, Message: fmt.Sprintf("can't sort by %q", name), Err: ErrNotSortable}
+	}
+
+	q.Sort = values[0]
+	return nil
+}
+
+// Query is a compiled set of filters and a sort order.
+type Query struct {
+	table      string
+	id         string
+	conditions []condition
+
+	// Sort is the sort parameter, empty to sort by id.
+	Sort string
+}
+
+// Mods returns the where clauses and order by clause of q.
+func (q Query) Mods() []qm.QueryMod {
+	return append(q.Where(), q.OrderBy())
+}
+
+// Where returns the where clauses selecting the rows matching the filters
+// of q.
+func (q Query) Where() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(q.conditions))
+	for _, c := range q.conditions {
+		mods = append(mods, c.mod)
+	}
+	return mods
+}
+
+// OrderBy returns the order by clause sorting rows by the sort order of q.
+// It must not be used on the zero Query.
+func (q Query) OrderBy() qm.QueryMod {
+	id := fmt.Sprintf("%q.%q", q.table, q.id)
+	name := strings.TrimPrefix(q.Sort, "-")
+	dir := "ASC"
+	if name != q.Sort {
+		dir = "DESC"
+	}
+
+	if name == "" || name == q.id {
+		return qm.OrderBy(fmt.Sprintf("%s %s", id, dir))
+	}
+	return qm.OrderBy(fmt.Sprintf("%q.%q %s NULLS LAST, %s %s", q.table, name, dir, id, dir))
+}
+
+// Match reports whether item, a model of the table of q, matches the
+// filters of q the way the where clauses would. It backs in-memory
+// implementations of the repositories.
+func (q Query) Match(item interface{}) bool {
+	for _, c := range q.conditions {
+		if !c.match(value(item, c.column)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Less reports whether a sorts before b in the sort order of q. a and b are
+// models of the table of q. Like OrderBy, it must not be used on the zero
+// Query.
+func (q Query) Less(a, b interface{}) bool {
+	name := strings.TrimPrefix(q.Sort, "-")
+	desc := name != q.Sort
+
+	c := 0
+	if name != "" && name != q.id {
+		av, bv := value(a, name), value(b, name)
+		switch {
+		case av == nil && bv == nil:
+		case av == nil:
+			return false
+		case bv == nil:
+			return true
+		default:
+			c = compare(av, bv)
+		}
+	}
+	if c == 0 {
+		c = compare(value(a, q.id), value(b, q.id))
+	}
+
+	if desc {
+		return c > 0
+	}
+	return c < 0
+}
+
+// condition is a compiled filter parameter.
+type condition struct {
+	column string
+	mod    qm.QueryMod
+	// match reports whether the column value v, normalized to a
+	// driver.Value, matches.
+	match func(v interface{}) bool
+}
+
+// Field is a column of a Schema.
+type Field struct {
+	column   string
+	ops      []string
+	sortable bool
+	// parse parses a value in the type of the where helper of the column.
+	parse func(s string) (interface{}, error)
+	// where returns the where clause of op for values, parsed by parse.
+	where func(op string, values []interface{}) qm.QueryMod
+}
+
+// Sortable returns f allowing to sort by the column.
+func (f Field) Sortable() Field {
+	f.sortable = true
+	return f
+}
+
+// opPattern matches the prefix of values that are meant to be an
+// operator.
+var opPattern = regexp.MustCompile(`^([a-z]+):`)
+
+func (f Field) compile(table, value string) (condition, error) {
+	op, raw := OpEq, value
+	if m := opPattern.FindStringSubmatch(value); m != nil {
+		op, raw = m[1], value[len(m[0]):]
+	}
+	if !contains(f.ops, op) {
+		return condition{}, &Error{
+			Field:   f.column,
+			Message: fmt.Sprintf("operator %q isn't supported, use one of %s", op, strings.Join(f.ops, ", ")),
+			Err:     ErrInvalidOperator,
+		}
+	}
+
+	c := condition{column: f.column}
+	switch op {
+	case OpNull:
+		var isNull bool
+		switch raw {
+		case "true":
+			isNull = true
+		case "false":
+		default:
+			return condition{}, f.invalid(raw, "must be true or false")
+		}
+
+		c.mod = f.where(op, []interface{}{isNull})
+		c.match = func(v interface{}) bool { return (v == nil) == isNull }
+	case OpLike:
+		// Escape the wildcards of LIKE, * becomes the only one.
+		pattern := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `*`, `%`).Replace(raw)
+		re := regexp.MustCompile(`(?s)^` + strings.ReplaceAll(regexp.QuoteMeta(raw), `\*`, `.*`) + `$`)
+
+		c.mod = qm.Where(fmt.Sprintf("%q.%q LIKE ?", table, f.column), pattern)
+		c.match = func(v interface{}) bool {
+			s, ok := v.(string)
+			return ok && re.MatchString(s)
+		}
+	default:
+		raws := []string{raw}
+		if op == OpIn || op == OpNin {
+			raws = strings.Split(raw, ",")
+		}
+
+		values := make([]interface{}, len(raws))
+		normalized := make([]interface{}, len(raws))
+		for i, raw := range raws {
+			v, err := f.parse(raw)
+			if err != nil {
+				return condition{}, f.invalid(raw, err.Error())
+			}
+			if normalized[i], err = normalize(v); err != nil {
+				return condition{}, f.invalid(raw, err.Error())
+			}
+			values[i] = v
+		}
+
+		c.mod = f.where(op, values)
+		c.match = func(v interface{}) bool {
+			if v == nil {
+				return false
+			}
+			return matches(op, v, normalized)
+		}
+	}
+
+	return c, nil
+}
+
+func (f Field) invalid(value, reason string) *Error {
+	return &Error{Field: f.column, Message: fmt.Sprintf("invalid value %q: %s", value, reason), Err: ErrInvalidValue}
+}
+
+// matches applies op to the column value v and the filter values.
+func matches(op string, v interface{}, values []interface{}) bool {
+	switch op {
+	case OpIn, OpNin:
+		found := false
+		for _, x := range values {
+			if compare(v, x) == 0 {
+				found = true
+				break
+			}
+		}
+		return found == (op == OpIn)
+	}
+
+	c := compare(v, values[0])
+	switch op {
+	case OpEq:
+		return c == 0
+	case OpNe:
+		return c != 0
+	case OpLt:
+		return c < 0
+	case OpLte:
+		return c <= 0
+	case OpGt:
+		return c > 0
+	case OpGte:
+		return c >= 0
+	}
+	return false
+}
+
+// compare compares two non-NULL values normalized to the same
+// driver.Value type.
+func compare(a, b interface{}) int {
+	switch a := a.(type) {
+	case int64:
+		b := b.(int64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(a, b.(string))
+	case time.Time:
+		b := b.(time.Time)
+		switch {
+		case a.Before(b):
+			return -1
+		case a.After(b):
+			return 1
+		}
+		return 0
+	}
+	panic(fmt.Sprintf("filter: can't compare %T", a))
+}
+
+// value returns the value of the field of the model item holding column,
+// normalized to a driver.Value, nil for NULL.
+func value(item interface{}, column string) interface{} {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if strings.Split(t.Field(i).Tag.Get("boil"), ",")[0] == column {
+			normalized, err := normalize(v.Field(i).Interface())
+			if err != nil {
+				panic(fmt.Sprintf("filter: unable to read %s: %v", column, err))
+			}
+			return normalized
+		}
+	}
+	panic(fmt.Sprintf("filter: %T has no column %s", item, column))
+}
+
+// normalize converts a model field value into one of the driver.Value
+// types, nil for NULL.
+func normalize(v interface{}) (interface{}, error) {
+	if valuer, ok := v.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(v)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// articles are the rows the filters are matched against.
+var articles = []*dbmodels.Article{
+	{
+		ID:        1,
+		Title:     "Hello world",
+		AuthorID:  1,
+		CreatedAt: null.TimeFrom(time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)),
+		Status:    dbmodels.ArticleStatusPublished,
+	},
+	{
+		ID:       2,
+		Title:    "100% sure_thing",
+		Body:     null.StringFrom(`C:\drafts`),
+		AuthorID: 2,
+		Status:   dbmodels.ArticleStatusDraft,
+	},
+	{
+		ID:        3,
+		Title:     "1000 sure things",
+		Body:      null.StringFrom(""),
+		AuthorID:  3,
+		CreatedAt: null.TimeFrom(time.Date(2024, time.January, 15, 23, 30, 0, 0, time.UTC)),
+		Status:    dbmodels.ArticleStatusScheduled,
+	},
+}
+
+// whereClause returns the where clause of q and its arguments.
+func whereClause(q Query) (string, []interface{}) {
+	mods := append([]qm.QueryMod{qm.From(`"article"`)}, q.Where()...)
+	query, args := queries.BuildQuery(dbmodels.NewQuery(mods...))
+	query = strings.TrimPrefix(query, `SELECT * FROM "article"`)
+	return strings.TrimSuffix(strings.TrimPrefix(query, " WHERE "), ";"), args
+}
+
+func TestParse(t *testing.T) {
+	// Dates are midnight in boil.GetLocation, an hour ahead of UTC here.
+	loc := time.FixedZone("UTC+1", 60*60)
+	defer boil.SetLocation(boil.GetLocation())
+	boil.SetLocation(loc)
+
+	tests := []struct {
+		params string
+		where  string
+		args   []interface{}
+		// matches are the ids of the articles Postgres selects with where.
+		matches []int
+	}{
+		{"author_id=2", `("article"."author_id" = $1)`, []interface{}{2}, []int{2}},
+		{"author_id=eq:2", `("article"."author_id" = $1)`, []interface{}{2}, []int{2}},
+		{"author_id=ne:2", `("article"."author_id" != $1)`, []interface{}{2}, []int{1, 3}},
+		{"author_id=lt:2", `("article"."author_id" < $1)`, []interface{}{2}, []int{1}},
+		{"author_id=lte:2", `("article"."author_id" <= $1)`, []interface{}{2}, []int{1, 2}},
+		{"author_id=gt:2", `("article"."author_id" > $1)`, []interface{}{2}, []int{3}},
+		{"author_id=gte:2", `("article"."author_id" >= $1)`, []interface{}{2}, []int{2, 3}},
+		{"author_id=in:1,3", `("article"."author_id" IN ($1,$2))`, []interface{}{1, 3}, []int{1, 3}},
+		{"author_id=nin:1,3", `("article"."author_id" NOT IN ($1,$2))`, []interface{}{1, 3}, []int{2}},
+		{"title=Hello world", `("article"."title" = $1)`, []interface{}{"Hello world"}, []int{1}},
+		{"title=like:Hello*", `("article"."title" LIKE $1)`, []interface{}{"Hello%"}, []int{1}},
+		// % and _ match themselves, not any characters, and \ isn't an
+		// escape.
+		{"title=like:100%25*", `("article"."title" LIKE $1)`, []interface{}{`100\%%`}, []int{2}},
+		{"title=like:*sure_thing*", `("article"."title" LIKE $1)`, []interface{}{`%sure\_thing%`}, []int{2}},
+		{`body=like:C:\*`, `("article"."body" LIKE $1)`, []interface{}{`C:\\%`}, []int{2}},
+		{"body=like:*", `("article"."body" LIKE $1)`, []interface{}{"%"}, []int{2, 3}},
+		{"body=null:true", `("article"."body" is null)`, nil, []int{1}},
+		{"body=null:false", `("article"."body" is not null)`, nil, []int{2, 3}},
+		// NULL matches no comparison.
+		{`body=ne:C:\drafts`, `("article"."body" != $1)`, []interface{}{null.StringFrom(`C:\drafts`)}, []int{3}},
+		{
+			"created_at=gte:2024-01-16",
+			`("article"."created_at" >= $1)`,
+			[]interface{}{null.TimeFrom(time.Date(2024, time.January, 16, 0, 0, 0, 0, loc))},
+			[]int{3},
+		},
+		{
+			"created_at=lt:2024-01-15T23:30:00Z",
+			`("article"."created_at" < $1)`,
+			[]interface{}{null.TimeFrom(time.Date(2024, time.January, 15, 23, 30, 0, 0, time.UTC))},
+			[]int{1},
+		},
+		{"status=draft", `("article"."status" = $1)`, []interface{}{dbmodels.ArticleStatusDraft}, []int{2}},
+		{"status=ne:draft", `("article"."status" != $1)`, []interface{}{dbmodels.ArticleStatusDraft}, []int{1, 3}},
+		{
+			"status=in:draft,scheduled",
+			`("article"."status" IN ($1,$2))`,
+			[]interface{}{dbmodels.ArticleStatusDraft, dbmodels.ArticleStatusScheduled},
+			[]int{2, 3},
+		},
+		{
+			"title=like:1*&author_id=gt:1&author_id=lt:3",
+			`("article"."author_id" > $1) AND ("article"."author_id" < $2) AND ("article"."title" LIKE $3)`,
+			[]interface{}{1, 3, "1%"},
+			[]int{2},
+		},
+		{"", ``, nil, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.params, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			q, err := Articles.Parse(values)
+			if err != nil {
+				t.Fatalf("Parse() = %v", err)
+			}
+
+			where, args := whereClause(q)
+			if where != tt.where {
+				t.Errorf("where = %s, want %s", where, tt.where)
+			}
+			if fmt.Sprint(args) != fmt.Sprint(tt.args) {
+				t.Errorf("args = %v, want %v", args, tt.args)
+			}
+
+			var matches []int
+			for _, article := range articles {
+				if q.Match(article) {
+					matches = append(matches, article.ID)
+				}
+			}
+			if !reflect.DeepEqual(matches, tt.matches) {
+				t.Errorf("Match() matches %v, want %v", matches, tt.matches)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		params string
+		field  string
+		err    error
+	}{
+		{"nope=1", "nope", ErrUnknownField},
+		{"deleted_at=null:true", "deleted_at", ErrUnknownField},
+		{"title=regex:^H", "title", ErrInvalidOperator},
+		{"author_id=like:1*", "author_id", ErrInvalidOperator},
+		{"author_id=null:true", "author_id", ErrInvalidOperator},
+		{"status=lt:draft", "status", ErrInvalidOperator},
+		{"created_at=like:2024*", "created_at", ErrInvalidOperator},
+		{"author_id=one", "author_id", ErrInvalidValue},
+		{"author_id=in:1,two", "author_id", ErrInvalidValue},
+		{"status=gone", "status", ErrInvalidValue},
+		{"body=null:maybe", "body", ErrInvalidValue},
+		{"created_at=gte:15/01/2024", "created_at", ErrInvalidValue},
+		{"sort=body", SortParam, ErrNotSortable},
+		{"sort=title&sort=id", SortParam, ErrInvalidValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.params, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = Articles.Parse(values)
+			var ferr *Error
+			if !errors.As(err, &ferr) || !errors.Is(err, tt.err) {
+				t.Fatalf("Parse() = %v, want %v", err, tt.err)
+			}
+			if ferr.Field != tt.field {
+				t.Errorf("Parse() failed on %s, want %s", ferr.Field, tt.field)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package filter
+
+import (
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+)
+
+// Articles is the Schema of the article table.
+var Articles = NewSchema(dbmodels.TableNames.Article,
+	Int(dbmodels.ArticleColumns.ID, dbmodels.ArticleWhere.ID).Sortable(),
+	String(dbmodels.ArticleColumns.Title, dbmodels.ArticleWhere.Title).Sortable(),
+	NullString(dbmodels.ArticleColumns.Body, dbmodels.ArticleWhere.Body),
+	NullTime(dbmodels.ArticleColumns.CreatedAt, dbmodels.ArticleWhere.CreatedAt).Sortable(),
+	Int(dbmodels.ArticleColumns.AuthorID, dbmodels.ArticleWhere.AuthorID),
+	Time(dbmodels.ArticleColumns.UpdatedAt, dbmodels.ArticleWhere.UpdatedAt).Sortable(),
+	Enum[dbmodels.ArticleStatus](dbmodels.ArticleColumns.Status, dbmodels.ArticleWhere.Status, dbmodels.AllArticleStatus()),
+	NullTime(dbmodels.ArticleColumns.PublishedAt, dbmodels.ArticleWhere.PublishedAt).Sortable(),
+)
+
+// Authors is the Schema of the author table.
+var Authors = NewSchema(dbmodels.TableNames.Author,
+	Int(dbmodels.AuthorColumns.ID, dbmodels.AuthorWhere.ID).Sortable(),
+	String(dbmodels.AuthorColumns.Name, dbmodels.AuthorWhere.Name).Sortable(),
+	String(dbmodels.AuthorColumns.Email, dbmodels.AuthorWhere.Email).Sortable(),
+	Time(dbmodels.AuthorColumns.UpdatedAt, dbmodels.AuthorWhere.UpdatedAt).Sortable(),
+)
+
+// AuditLog is the Schema of the audit_log table.
+var AuditLog = NewSchema(dbmodels.TableNames.AuditLog,
+	NullString(dbmodels.AuditLogColumns.Actor, dbmodels.AuditLogWhere.Actor),
+	Enum[dbmodels.AuditOperation](dbmodels.AuditLogColumns.Operation, dbmodels.AuditLogWhere.Operation, dbmodels.AllAuditOperation()),
+	String(dbmodels.AuditLogColumns.TableName, dbmodels.AuditLogWhere.TableName),
+	Int(dbmodels.AuditLogColumns.RowID, dbmodels.AuditLogWhere.RowID),
+	Time(dbmodels.AuditLogColumns.CreatedAt, dbmodels.AuditLogWhere.CreatedAt).Sortable(),
+)
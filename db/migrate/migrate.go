@@ -0,0 +1,380 @@
+// Package migrate applies versioned SQL migrations, such as the ones
+// embedded by db/migrations, and records them in the schema_migrations
+// table.
+//
+// Migrations are applied one by one, each in its own transaction together
+// with its schema_migrations row. The checksum of every applied migration
+// is stored so edits to migrations that already ran are detected, and a
+// Postgres advisory lock keeps concurrent runners from applying the same
+// migration twice.
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// lockKey identifies the advisory lock held while migrating. It is an
+// arbitrary constant that only has to be the same for every runner.
+const lockKey int64 = 7283451029384756
+
+const createTable = `CREATE TABLE IF NOT EXISTS schema_migrations(
+  version int primary key,
+  name varchar not null,
+  checksum varchar not null,
+  applied_at timestamptz not null default now()
+)`
+
+// Migration is one version of the schema.
+type Migration struct {
+	Version int
+	Name    string
+	// Up and Down are the SQL applying and reverting the migration. Down
+	// is empty for migrations that can't be reverted.
+	Up   string
+	Down string
+	// Checksum is the hex encoded SHA-256 of Up.
+	Checksum string
+}
+
+// States of a migration reported by Status.
+const (
+	StatePending = "pending"
+	StateApplied = "applied"
+	// StateModified marks applied migrations whose file changed since.
+	StateModified = "modified"
+	// StateMissing marks applied migrations that have no file.
+	StateMissing = "missing"
+)
+
+// Status describes a migration and whether it was applied.
+type Status struct {
+	Version   int        `json:"version"`
+	Name      string     `json:"name"`
+	State     string     `json:"state"`
+	AppliedAt *time.Time `json:"applied_at"`
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Load reads the migrations in the root of fsys. Files must be named
+// NNNN_name.up.sql and NNNN_name.down.sql, the down file is optional.
+// Versions must start at 1 and have no gaps.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("migrate: unable to read migrations: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		m := fileName.FindStringSubmatch(entry.Name())
+		if m == nil {
+			return nil, fmt.Errorf("migrate: %s isn't named NNNN_name.up.sql or NNNN_name.down.sql", entry.Name())
+		}
+		version, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("migrate: %s: %w", entry.Name(), err)
+		}
+
+		b, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("migrate: unable to read %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != m[2] {
+			return nil, fmt.Errorf("migrate: version %d is used by both %s and %s", version, migration.Name, m[2])
+		}
+
+		if m[3] == "up" {
+			migration.Up = string(b)
+			sum := sha256.Sum256(b)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(b)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Checksum == "" {
+			return nil, fmt.Errorf("migrate: version %d (%s) has no up migration", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			return nil, fmt.Errorf("migrate: expected version %d, found %d (%s)", i+1, migration.Version, migration.Name)
+		}
+	}
+
+	return migrations, nil
+}
+
+// Migrator applies migrations to a database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New returns a Migrator applying the migrations in fsys to db.
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// applied is a row of schema_migrations.
+type applied struct {
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// Status returns the state of every known and every applied migration,
+// ordered by version. It doesn't take the lock, so it may observe a
+// migration run in progress.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	done, err := m.applied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []Status
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name, State: StatePending}
+		if a, ok := done[migration.Version]; ok {
+			status.State = StateApplied
+			if a.checksum != migration.Checksum {
+				status.State = StateModified
+			}
+			appliedAt := a.appliedAt
+			status.AppliedAt = &appliedAt
+			delete(done, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for version, a := range done {
+		appliedAt := a.appliedAt
+		statuses = append(statuses, Status{Version: version, Name: a.name, State: StateMissing, AppliedAt: &appliedAt})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+
+	return statuses, nil
+}
+
+// Up applies up to n pending migrations, or all of them if n <= 0, and
+// returns the migrations applied. It fails without applying anything if an
+// applied migration was modified or is missing.
+func (m *Migrator) Up(ctx context.Context, n int) ([]Migration, error) {
+	var ran []Migration
+
+	err := m.locked(ctx, func(conn *sql.Conn, done map[int]applied) error {
+		for _, migration := range m.migrations {
+			if n > 0 && len(ran) == n {
+				return nil
+			}
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx,
+					"INSERT INTO schema_migrations(version, name, checksum) VALUES ($1, $2, $3)",
+					migration.Version, migration.Name, migration.Checksum,
+				)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migrate: unable to apply %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			ran = append(ran, migration)
+		}
+		return nil
+	})
+
+	return ran, err
+}
+
+// Down reverts up to n applied migrations, or all of them if n <= 0,
+// latest first, and returns the migrations reverted. It fails without
+// reverting anything if an applied migration was modified or is missing.
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	var ran []Migration
+
+	err := m.locked(ctx, func(conn *sql.Conn, done map[int]applied) error {
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if n > 0 && len(ran) == n {
+				return nil
+			}
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("migrate: %d_%s can't be reverted, it has no down migration", migration.Version, migration.Name)
+			}
+
+			err := inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+					return err
+				}
+				_, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("migrate: unable to revert %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			ran = append(ran, migration)
+		}
+		return nil
+	})
+
+	return ran, err
+}
+
+// locked runs fn on a connection holding the migration lock, with the
+// migrations applied so far. The advisory lock belongs to the session, so
+// everything has to run on that one connection.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn, done map[int]applied) error) (err error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: unable to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("migrate: unable to take lock: %w", err)
+	}
+	defer func() {
+		// Use a fresh context, the lock must be released even if ctx was
+		// canceled.
+		if _, unlockErr := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey); unlockErr != nil && err == nil {
+			err = fmt.Errorf("migrate: unable to release lock: %w", unlockErr)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return fmt.Errorf("migrate: unable to create schema_migrations: %w", err)
+	}
+
+	done, err := m.applied(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if err := m.verify(done); err != nil {
+		return err
+	}
+
+	return fn(conn, done)
+}
+
+// verify checks that every applied migration is known and unchanged.
+func (m *Migrator) verify(done map[int]applied) error {
+	known := make(map[int]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = migration
+	}
+
+	versions := make([]int, 0, len(done))
+	for version := range done {
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+
+	for _, version := range versions {
+		a := done[version]
+		migration, ok := known[version]
+		if !ok {
+			return fmt.Errorf("migrate: applied migration %d_%s is missing", version, a.name)
+		}
+		if a.checksum != migration.Checksum {
+			return fmt.Errorf("migrate: applied migration %d_%s was modified", version, a.name)
+		}
+	}
+
+	return nil
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// applied returns the rows of schema_migrations by version. A missing
+// table means nothing was applied yet.
+func (m *Migrator) applied(ctx context.Context, q queryer) (map[int]applied, error) {
+	var exists bool
+	if err := q.QueryRowContext(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, fmt.Errorf("migrate: unable to read schema_migrations: %w", err)
+	}
+
+	done := map[int]applied{}
+	if !exists {
+		return done, nil
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT version, name, checksum, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("migrate: unable to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int
+		var a applied
+		if err := rows.Scan(&version, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			return nil, fmt.Errorf("migrate: unable to read schema_migrations: %w", err)
+		}
+		done[version] = a
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("migrate: unable to read schema_migrations: %w", err)
+	}
+
+	return done, nil
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -1,14 +1,16 @@
-CREATE TABLE author(
+-- The initial schema, formerly init.sql. IF NOT EXISTS lets databases that
+-- were created from init.sql adopt migrations without being recreated.
+CREATE TABLE IF NOT EXISTS author(
   id serial primary key,
   email varchar not null,
   name varchar not null
 );
 
-CREATE TABLE article(
+CREATE TABLE IF NOT EXISTS article(
   id serial primary key,
   title varchar not null,
   body text,
   created_at timestamp default now(),
   author_id int not null,
   constraint fk_author_id foreign key(author_id) references author(id)
-);
\ No newline at end of file
+);
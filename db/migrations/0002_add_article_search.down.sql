@@ -0,0 +1 @@
+DROP INDEX article_search_idx;
@@ -0,0 +1,7 @@
+-- Full-text search over the title and body of articles, used by
+-- dbmodels.SearchArticles. Queries only use the index if they repeat the
+-- expression exactly, including the text search configuration.
+CREATE INDEX article_search_idx ON article USING gin ((
+  setweight(to_tsvector('english', title), 'A') ||
+  setweight(to_tsvector('english', coalesce(body, '')), 'B')
+));
@@ -0,0 +1,2 @@
+ALTER TABLE article DROP COLUMN deleted_at;
+ALTER TABLE author DROP COLUMN deleted_at;
@@ -0,0 +1,9 @@
+-- Deleting an author or article sets deleted_at instead of removing the row,
+-- see the add-soft-deletes option in sqlboiler.toml. Rows are removed for
+-- good by the purge command once they have been deleted for long enough.
+ALTER TABLE author ADD COLUMN deleted_at timestamp;
+ALTER TABLE article ADD COLUMN deleted_at timestamp;
+
+-- Purging looks up rows deleted before a cutoff.
+CREATE INDEX author_deleted_at_idx ON author (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX article_deleted_at_idx ON article (deleted_at) WHERE deleted_at IS NOT NULL;
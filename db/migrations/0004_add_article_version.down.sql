@@ -0,0 +1,3 @@
+DROP TRIGGER article_bump_version ON article;
+DROP FUNCTION bump_version();
+ALTER TABLE article DROP COLUMN version;
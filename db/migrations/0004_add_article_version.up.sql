@@ -0,0 +1,15 @@
+-- Optimistic locking of articles: the generated Update and Upsert only
+-- write an article if its version is still the one that was loaded, see
+-- templates/main. The trigger increments version on every update, however
+-- the row is written.
+ALTER TABLE article ADD COLUMN version integer NOT NULL DEFAULT 0;
+
+CREATE FUNCTION bump_version() RETURNS trigger AS $$
+BEGIN
+  NEW.version := OLD.version + 1;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER article_bump_version BEFORE UPDATE ON article
+  FOR EACH ROW EXECUTE FUNCTION bump_version();
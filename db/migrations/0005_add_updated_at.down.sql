@@ -0,0 +1,2 @@
+ALTER TABLE article DROP COLUMN updated_at;
+ALTER TABLE author DROP COLUMN updated_at;
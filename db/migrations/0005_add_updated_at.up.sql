@@ -0,0 +1,9 @@
+-- updated_at is set by the generated Insert, Update, UpdateAll and Upsert,
+-- see templates/main, so sync jobs can select the rows changed since their
+-- last run with dbmodels.ChangedSince. Existing rows count as changed when
+-- the migration runs.
+ALTER TABLE author ADD COLUMN updated_at timestamp NOT NULL DEFAULT now();
+ALTER TABLE article ADD COLUMN updated_at timestamp NOT NULL DEFAULT now();
+
+CREATE INDEX author_updated_at_idx ON author (updated_at);
+CREATE INDEX article_updated_at_idx ON article (updated_at);
@@ -0,0 +1,12 @@
+-- Timestamps are stored as points in time instead of wall clock times, so
+-- they no longer depend on the time zone of the session or of the
+-- application writing them. The models wrote them in UTC, the default of
+-- boil.SetLocation, which is how existing values are read.
+ALTER TABLE author
+  ALTER COLUMN deleted_at TYPE timestamptz USING deleted_at AT TIME ZONE 'UTC',
+  ALTER COLUMN updated_at TYPE timestamptz USING updated_at AT TIME ZONE 'UTC';
+
+ALTER TABLE article
+  ALTER COLUMN created_at TYPE timestamptz USING created_at AT TIME ZONE 'UTC',
+  ALTER COLUMN deleted_at TYPE timestamptz USING deleted_at AT TIME ZONE 'UTC',
+  ALTER COLUMN updated_at TYPE timestamptz USING updated_at AT TIME ZONE 'UTC';
@@ -0,0 +1,2 @@
+DROP TABLE article_tag;
+DROP TABLE tag;
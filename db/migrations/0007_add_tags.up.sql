@@ -0,0 +1,19 @@
+-- Articles are categorized with tags. article_tag only holds the two keys,
+-- so sqlboiler treats it as a join table and gives Article R.Tags and Tag
+-- R.Articles instead of generating a model for it.
+CREATE TABLE tag (
+  id serial PRIMARY KEY,
+  name varchar NOT NULL,
+  CONSTRAINT tag_name_key UNIQUE (name)
+);
+
+CREATE TABLE article_tag (
+  article_id integer NOT NULL,
+  tag_id integer NOT NULL,
+  PRIMARY KEY (article_id, tag_id),
+  CONSTRAINT fk_article_id FOREIGN KEY (article_id) REFERENCES article (id) ON DELETE CASCADE,
+  CONSTRAINT fk_tag_id FOREIGN KEY (tag_id) REFERENCES tag (id) ON DELETE CASCADE
+);
+
+-- The primary key covers lookups by article, this one those by tag.
+CREATE INDEX article_tag_tag_id_idx ON article_tag (tag_id);
@@ -0,0 +1,4 @@
+DROP TRIGGER article_credit_primary_author ON article;
+DROP FUNCTION credit_primary_author();
+DROP TABLE article_author;
+DROP TYPE article_author_role;
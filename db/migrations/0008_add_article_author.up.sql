@@ -0,0 +1,40 @@
+-- Articles are credited to several authors through article_author, each
+-- with a role. Roles are ordered like the enum, so ORDER BY role lists the
+-- primary author first; position orders the authors within a role.
+-- article_author has columns besides the two keys, so sqlboiler generates
+-- an ArticleAuthor model for it, see db/models/coauthors.go.
+CREATE TYPE article_author_role AS ENUM ('primary', 'contributor', 'editor');
+
+CREATE TABLE article_author (
+  article_id integer NOT NULL,
+  author_id integer NOT NULL,
+  role article_author_role NOT NULL,
+  position integer NOT NULL DEFAULT 0,
+  PRIMARY KEY (article_id, author_id),
+  CONSTRAINT fk_article_id FOREIGN KEY (article_id) REFERENCES article (id) ON DELETE CASCADE,
+  CONSTRAINT fk_author_id FOREIGN KEY (author_id) REFERENCES author (id) ON DELETE CASCADE
+);
+
+-- The primary key covers lookups by article, this one those by author.
+CREATE INDEX article_author_author_id_idx ON article_author (author_id);
+CREATE UNIQUE INDEX article_author_primary_idx ON article_author (article_id) WHERE role = 'primary';
+
+-- article.author_id stays the primary author. The trigger credits it
+-- whenever it is written, however the row is written, replacing the
+-- previous primary author.
+CREATE FUNCTION credit_primary_author() RETURNS trigger AS $$
+BEGIN
+  DELETE FROM article_author
+    WHERE article_id = NEW.id AND role = 'primary' AND author_id <> NEW.author_id;
+  INSERT INTO article_author (article_id, author_id, role, position)
+    VALUES (NEW.id, NEW.author_id, 'primary', 0)
+    ON CONFLICT (article_id, author_id) DO UPDATE SET role = 'primary', position = 0;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER article_credit_primary_author AFTER INSERT OR UPDATE OF author_id ON article
+  FOR EACH ROW EXECUTE FUNCTION credit_primary_author();
+
+INSERT INTO article_author (article_id, author_id, role)
+  SELECT id, author_id, 'primary' FROM article;
@@ -0,0 +1,2 @@
+ALTER TABLE article DROP COLUMN published_at, DROP COLUMN status;
+DROP TYPE article_status;
@@ -0,0 +1,23 @@
+-- Articles go through a publishing workflow, see db/models/publishing.go
+-- for the transitions between the statuses. New articles are drafts;
+-- articles that existed before count as published when they were created.
+-- published_at is when an article was or is scheduled to be published.
+CREATE TYPE article_status AS ENUM ('draft', 'in_review', 'scheduled', 'published', 'archived');
+
+ALTER TABLE article
+  ADD COLUMN status article_status NOT NULL DEFAULT 'published',
+  ADD COLUMN published_at timestamptz;
+
+ALTER TABLE article ALTER COLUMN status SET DEFAULT 'draft';
+
+-- Backfilling published_at doesn't change the articles, so it doesn't
+-- bump their version.
+ALTER TABLE article DISABLE TRIGGER article_bump_version;
+UPDATE article SET published_at = coalesce(created_at, updated_at);
+ALTER TABLE article ENABLE TRIGGER article_bump_version;
+
+ALTER TABLE article ADD CONSTRAINT article_published_at_check
+  CHECK (status NOT IN ('scheduled', 'published') OR published_at IS NOT NULL);
+
+-- Readers list published articles, newest first.
+CREATE INDEX article_published_at_idx ON article (published_at) WHERE status = 'published';
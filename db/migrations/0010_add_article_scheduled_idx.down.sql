@@ -0,0 +1 @@
+DROP INDEX article_scheduled_idx;
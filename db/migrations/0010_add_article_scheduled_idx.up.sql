@@ -0,0 +1,2 @@
+-- The publishing worker polls for scheduled articles that are due.
+CREATE INDEX article_scheduled_idx ON article (published_at) WHERE status = 'scheduled';
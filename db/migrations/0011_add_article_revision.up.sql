@@ -0,0 +1,16 @@
+-- article_revision keeps every version of the title and body of articles,
+-- written by the article hooks in db/models/revisions.go. Versions that
+-- only changed other columns, such as the status, have no revision.
+CREATE TABLE article_revision (
+  article_id integer NOT NULL,
+  version integer NOT NULL,
+  title varchar NOT NULL,
+  body text,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  PRIMARY KEY (article_id, version),
+  CONSTRAINT fk_article_id FOREIGN KEY (article_id) REFERENCES article (id) ON DELETE CASCADE
+);
+
+-- Existing articles start their history at their current version.
+INSERT INTO article_revision (article_id, version, title, body, created_at)
+  SELECT id, version, title, body, updated_at FROM article;
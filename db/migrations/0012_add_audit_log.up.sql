@@ -0,0 +1,23 @@
+-- audit_log records who inserted, updated, upserted or deleted which
+-- author or article, with the row as JSON before and after the change. It
+-- is written by the model hooks in db/models/audit.go and by the purges
+-- in db/models/soft_delete.go. Entries outlive the rows they are about, so
+-- row_id references nothing.
+CREATE TYPE audit_operation AS ENUM ('insert', 'update', 'upsert', 'delete');
+
+CREATE TABLE audit_log (
+  id bigserial PRIMARY KEY,
+  actor varchar,
+  operation audit_operation NOT NULL,
+  table_name varchar NOT NULL,
+  row_id integer NOT NULL,
+  before jsonb,
+  after jsonb,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- The history of a row and the changes made by an actor, both newest
+-- first.
+CREATE INDEX audit_log_row_idx ON audit_log (table_name, row_id, id);
+CREATE INDEX audit_log_actor_idx ON audit_log (actor, id);
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at);
@@ -0,0 +1,12 @@
+// Package migrations embeds the SQL migrations of the schema, applied with
+// the migrate command.
+//
+// Each migration is a pair of files named NNNN_name.up.sql and
+// NNNN_name.down.sql, numbered without gaps. Applied migrations are
+// checksummed, never edit them; add a new migration instead.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -24,42 +24,67 @@ import (
 
 // Article is an object representing the database table.
 type Article struct {
-	ID        int         `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Title     string      `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Body      null.String `boil:"body" json:"body,omitempty" toml:"body" yaml:"body,omitempty"`
-	CreatedAt null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	AuthorID  int         `boil:"author_id" json:"author_id" toml:"author_id" yaml:"author_id"`
+	ID          int           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Title       string        `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Body        null.String   `boil:"body" json:"body,omitempty" toml:"body" yaml:"body,omitempty"`
+	CreatedAt   null.Time     `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	AuthorID    int           `boil:"author_id" json:"author_id" toml:"author_id" yaml:"author_id"`
+	DeletedAt   null.Time     `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Version     int           `boil:"version" json:"version" toml:"version" yaml:"version"`
+	UpdatedAt   time.Time     `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Status      ArticleStatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	PublishedAt null.Time     `boil:"published_at" json:"published_at,omitempty" toml:"published_at" yaml:"published_at,omitempty"`
 
 	R *articleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L articleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ArticleColumns = struct {
-	ID        string
-	Title     string
-	Body      string
-	CreatedAt string
-	AuthorID  string
+	ID          string
+	Title       string
+	Body        string
+	CreatedAt   string
+	AuthorID    string
+	DeletedAt   string
+	Version     string
+	UpdatedAt   string
+	Status      string
+	PublishedAt string
 }{
-	ID:        "id",
-	Title:     "title",
-	Body:      "body",
-	CreatedAt: "created_at",
-	AuthorID:  "author_id",
+	ID:          "id",
+	Title:       "title",
+	Body:        "body",
+	CreatedAt:   "created_at",
+	AuthorID:    "author_id",
+	DeletedAt:   "deleted_at",
+	Version:     "version",
+	UpdatedAt:   "updated_at",
+	Status:      "status",
+	PublishedAt: "published_at",
 }
 
 var ArticleTableColumns = struct {
-	ID        string
-	Title     string
-	Body      string
-	CreatedAt string
-	AuthorID  string
+	ID          string
+	Title       string
+	Body        string
+	CreatedAt   string
+	AuthorID    string
+	DeletedAt   string
+	Version     string
+	UpdatedAt   string
+	Status      string
+	PublishedAt string
 }{
-	ID:        "article.id",
-	Title:     "article.title",
-	Body:      "article.body",
-	CreatedAt: "article.created_at",
-	AuthorID:  "article.author_id",
+	ID:          "article.id",
+	Title:       "article.title",
+	Body:        "article.body",
+	CreatedAt:   "article.created_at",
+	AuthorID:    "article.author_id",
+	DeletedAt:   "article.deleted_at",
+	Version:     "article.version",
+	UpdatedAt:   "article.updated_at",
+	Status:      "article.status",
+	PublishedAt: "article.published_at",
 }
 
 // Generated where
@@ -158,30 +183,105 @@ func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
 func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperArticleStatus struct{ field string }
+
+func (w whereHelperArticleStatus) EQ(x ArticleStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperArticleStatus) NEQ(x ArticleStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperArticleStatus) LT(x ArticleStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperArticleStatus) LTE(x ArticleStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperArticleStatus) GT(x ArticleStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperArticleStatus) GTE(x ArticleStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperArticleStatus) IN(slice []ArticleStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperArticleStatus) NIN(slice []ArticleStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var ArticleWhere = struct {
-	ID        whereHelperint
-	Title     whereHelperstring
-	Body      whereHelpernull_String
-	CreatedAt whereHelpernull_Time
-	AuthorID  whereHelperint
+	ID          whereHelperint
+	Title       whereHelperstring
+	Body        whereHelpernull_String
+	CreatedAt   whereHelpernull_Time
+	AuthorID    whereHelperint
+	DeletedAt   whereHelpernull_Time
+	Version     whereHelperint
+	UpdatedAt   whereHelpertime_Time
+	Status      whereHelperArticleStatus
+	PublishedAt whereHelpernull_Time
 }{
-	ID:        whereHelperint{field: "\"article\".\"id\""},
-	Title:     whereHelperstring{field: "\"article\".\"title\""},
-	Body:      whereHelpernull_String{field: "\"article\".\"body\""},
-	CreatedAt: whereHelpernull_Time{field: "\"article\".\"created_at\""},
-	AuthorID:  whereHelperint{field: "\"article\".\"author_id\""},
+	ID:          whereHelperint{field: "\"article\".\"id\""},
+	Title:       whereHelperstring{field: "\"article\".\"title\""},
+	Body:        whereHelpernull_String{field: "\"article\".\"body\""},
+	CreatedAt:   whereHelpernull_Time{field: "\"article\".\"created_at\""},
+	AuthorID:    whereHelperint{field: "\"article\".\"author_id\""},
+	DeletedAt:   whereHelpernull_Time{field: "\"article\".\"deleted_at\""},
+	Version:     whereHelperint{field: "\"article\".\"version\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"article\".\"updated_at\""},
+	Status:      whereHelperArticleStatus{field: "\"article\".\"status\""},
+	PublishedAt: whereHelpernull_Time{field: "\"article\".\"published_at\""},
 }
 
 // ArticleRels is where relationship names are stored.
 var ArticleRels = struct {
-	Author string
+	Author           string
+	ArticleAuthors   string
+	ArticleRevisions string
+	Tags             string
 }{
-	Author: "Author",
+	Author:           "Author",
+	ArticleAuthors:   "ArticleAuthors",
+	ArticleRevisions: "ArticleRevisions",
+	Tags:             "Tags",
 }
 
 // articleR is where relationships are stored.
 type articleR struct {
-	Author *Author `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
+	Author           *Author              `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
+	ArticleAuthors   ArticleAuthorSlice   `boil:"ArticleAuthors" json:"ArticleAuthors" toml:"ArticleAuthors" yaml:"ArticleAuthors"`
+	ArticleRevisions ArticleRevisionSlice `boil:"ArticleRevisions" json:"ArticleRevisions" toml:"ArticleRevisions" yaml:"ArticleRevisions"`
+	Tags             TagSlice             `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
 }
 
 // NewStruct creates a new relationship struct
@@ -196,13 +296,34 @@ func (r *articleR) GetAuthor() *Author {
 	return r.Author
 }
 
+func (r *articleR) GetArticleAuthors() ArticleAuthorSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArticleAuthors
+}
+
+func (r *articleR) GetArticleRevisions() ArticleRevisionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArticleRevisions
+}
+
+func (r *articleR) GetTags() TagSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Tags
+}
+
 // articleL is where Load methods for each relationship are stored.
 type articleL struct{}
 
 var (
-	articleAllColumns            = []string{"id", "title", "body", "created_at", "author_id"}
+	articleAllColumns            = []string{"id", "title", "body", "created_at", "author_id", "deleted_at", "version", "updated_at", "status", "published_at"}
 	articleColumnsWithoutDefault = []string{"title", "author_id"}
-	articleColumnsWithDefault    = []string{"id", "body", "created_at"}
+	articleColumnsWithDefault    = []string{"id", "body", "created_at", "deleted_at", "version", "updated_at", "status", "published_at"}
 	articlePrimaryKeyColumns     = []string{"id"}
 	articleGeneratedColumns      = []string{}
 )
@@ -505,20 +626,412 @@ func (q articleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bo
 	return count > 0, nil
 }
 
-// Author pointed to by the foreign key.
-func (o *Article) Author(mods ...qm.QueryMod) authorQuery {
-	queryMods := []qm.QueryMod{
-		qm.Where("\"id\" = ?", o.AuthorID),
+// Author pointed to by the foreign key.
+func (o *Article) Author(mods ...qm.QueryMod) authorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AuthorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Authors(queryMods...)
+}
+
+// ArticleAuthors retrieves all the article_author's ArticleAuthors with an executor.
+func (o *Article) ArticleAuthors(mods ...qm.QueryMod) articleAuthorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"article_author\".\"article_id\"=?", o.ID),
+	)
+
+	return ArticleAuthors(queryMods...)
+}
+
+// ArticleRevisions retrieves all the article_revision's ArticleRevisions with an executor.
+func (o *Article) ArticleRevisions(mods ...qm.QueryMod) articleRevisionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"article_revision\".\"article_id\"=?", o.ID),
+	)
+
+	return ArticleRevisions(queryMods...)
+}
+
+// Tags retrieves all the tag's Tags with an executor.
+func (o *Article) Tags(mods ...qm.QueryMod) tagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"article_tag\" on \"tag\".\"id\" = \"article_tag\".\"tag_id\""),
+		qm.Where("\"article_tag\".\"article_id\"=?", o.ID),
+	)
+
+	return Tags(queryMods...)
+}
+
+// LoadAuthor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticle.(*Article)
+		if !ok {
+			object = new(Article)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticle))
+			}
+		}
+	} else {
+		s, ok := maybeArticle.(*[]*Article)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticle))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		args = append(args, object.AuthorID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AuthorID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AuthorID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`author`),
+		qm.WhereIn(`author.id in ?`, args...),
+		qmhelper.WhereIsNull(`author.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Author")
+	}
+
+	var resultSlice []*Author
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Author")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for author")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for author")
+	}
+
+	if len(articleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Author = foreign
+		if foreign.R == nil {
+			foreign.R = &authorR{}
+		}
+		foreign.R.Articles = append(foreign.R.Articles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AuthorID == foreign.ID {
+				local.R.Author = foreign
+				if foreign.R == nil {
+					foreign.R = &authorR{}
+				}
+				foreign.R.Articles = append(foreign.R.Articles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadArticleAuthors allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (articleL) LoadArticleAuthors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticle.(*Article)
+		if !ok {
+			object = new(Article)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticle))
+			}
+		}
+	} else {
+		s, ok := maybeArticle.(*[]*Article)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticle))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`article_author`),
+		qm.WhereIn(`article_author.article_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load article_author")
+	}
+
+	var resultSlice []*ArticleAuthor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice article_author")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on article_author")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article_author")
+	}
+
+	if len(articleAuthorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArticleAuthors = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &articleAuthorR{}
+			}
+			foreign.R.Article = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ArticleID {
+				local.R.ArticleAuthors = append(local.R.ArticleAuthors, foreign)
+				if foreign.R == nil {
+					foreign.R = &articleAuthorR{}
+				}
+				foreign.R.Article = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadArticleRevisions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (articleL) LoadArticleRevisions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+	var slice []*Article
+	var object *Article
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticle.(*Article)
+		if !ok {
+			object = new(Article)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticle))
+			}
+		}
+	} else {
+		s, ok := maybeArticle.(*[]*Article)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticle))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`article_revision`),
+		qm.WhereIn(`article_revision.article_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load article_revision")
+	}
+
+	var resultSlice []*ArticleRevision
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice article_revision")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on article_revision")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article_revision")
+	}
+
+	if len(articleRevisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArticleRevisions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &articleRevisionR{}
+			}
+			foreign.R.Article = object
+		}
+		return nil
 	}
 
-	queryMods = append(queryMods, mods...)
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ArticleID {
+				local.R.ArticleRevisions = append(local.R.ArticleRevisions, foreign)
+				if foreign.R == nil {
+					foreign.R = &articleRevisionR{}
+				}
+				foreign.R.Article = local
+				break
+			}
+		}
+	}
 
-	return Authors(queryMods...)
+	return nil
 }
 
-// LoadAuthor allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (articleL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
+// LoadTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (articleL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticle interface{}, mods queries.Applicator) error {
 	var slice []*Article
 	var object *Article
 
@@ -549,8 +1062,7 @@ func (articleL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular
 		if object.R == nil {
 			object.R = &articleR{}
 		}
-		args = append(args, object.AuthorID)
-
+		args = append(args, object.ID)
 	} else {
 	Outer:
 		for _, obj := range slice {
@@ -559,13 +1071,12 @@ func (articleL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular
 			}
 
 			for _, a := range args {
-				if a == obj.AuthorID {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.AuthorID)
-
+			args = append(args, obj.ID)
 		}
 	}
 
@@ -574,8 +1085,10 @@ func (articleL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`author`),
-		qm.WhereIn(`author.id in ?`, args...),
+		qm.Select("\"tag\".\"id\", \"tag\".\"name\", \"a\".\"article_id\""),
+		qm.From("\"tag\""),
+		qm.InnerJoin("\"article_tag\" as \"a\" on \"tag\".\"id\" = \"a\".\"tag_id\""),
+		qm.WhereIn("\"a\".\"article_id\" in ?", args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -583,49 +1096,60 @@ func (articleL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Author")
+		return errors.Wrap(err, "failed to eager load tag")
 	}
 
-	var resultSlice []*Author
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Author")
+	var resultSlice []*Tag
+
+	var localJoinCols []int
+	for results.Next() {
+		one := new(Tag)
+		var localJoinCol int
+
+		err = results.Scan(&one.ID, &one.Name, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for tag")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice tag")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for author")
+		return errors.Wrap(err, "failed to close results in eager load on tag")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for author")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tag")
 	}
 
-	if len(articleAfterSelectHooks) != 0 {
+	if len(tagAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
-
-	if len(resultSlice) == 0 {
-		return nil
-	}
-
 	if singular {
-		foreign := resultSlice[0]
-		object.R.Author = foreign
-		if foreign.R == nil {
-			foreign.R = &authorR{}
+		object.R.Tags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tagR{}
+			}
+			foreign.R.Articles = append(foreign.R.Articles, object)
 		}
-		foreign.R.Articles = append(foreign.R.Articles, object)
 		return nil
 	}
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if local.AuthorID == foreign.ID {
-				local.R.Author = foreign
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Tags = append(local.R.Tags, foreign)
 				if foreign.R == nil {
-					foreign.R = &authorR{}
+					foreign.R = &tagR{}
 				}
 				foreign.R.Articles = append(foreign.R.Articles, local)
 				break
@@ -691,9 +1215,306 @@ func (o *Article) SetAuthor(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddArticleAuthorsG adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.ArticleAuthors.
+// Sets related.R.Article appropriately.
+// Uses the global database handle.
+func (o *Article) AddArticleAuthorsG(ctx context.Context, insert bool, related ...*ArticleAuthor) error {
+	return o.AddArticleAuthors(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddArticleAuthors adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.ArticleAuthors.
+// Sets related.R.Article appropriately.
+func (o *Article) AddArticleAuthors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArticleAuthor) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ArticleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"article_author\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"article_id"}),
+				strmangle.WhereClause("\"", "\"", 2, articleAuthorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ArticleID, rel.AuthorID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ArticleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &articleR{
+			ArticleAuthors: related,
+		}
+	} else {
+		o.R.ArticleAuthors = append(o.R.ArticleAuthors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &articleAuthorR{
+				Article: o,
+			}
+		} else {
+			rel.R.Article = o
+		}
+	}
+	return nil
+}
+
+// AddArticleRevisionsG adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.ArticleRevisions.
+// Sets related.R.Article appropriately.
+// Uses the global database handle.
+func (o *Article) AddArticleRevisionsG(ctx context.Context, insert bool, related ...*ArticleRevision) error {
+	return o.AddArticleRevisions(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddArticleRevisions adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.ArticleRevisions.
+// Sets related.R.Article appropriately.
+func (o *Article) AddArticleRevisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArticleRevision) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ArticleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"article_revision\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"article_id"}),
+				strmangle.WhereClause("\"", "\"", 2, articleRevisionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ArticleID, rel.Version}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ArticleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &articleR{
+			ArticleRevisions: related,
+		}
+	} else {
+		o.R.ArticleRevisions = append(o.R.ArticleRevisions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &articleRevisionR{
+				Article: o,
+			}
+		} else {
+			rel.R.Article = o
+		}
+	}
+	return nil
+}
+
+// AddTagsG adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.Tags.
+// Sets related.R.Articles appropriately.
+// Uses the global database handle.
+func (o *Article) AddTagsG(ctx context.Context, insert bool, related ...*Tag) error {
+	return o.AddTags(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTags adds the given related objects to the existing relationships
+// of the article, optionally inserting them as new records.
+// Appends related to o.R.Tags.
+// Sets related.R.Articles appropriately.
+func (o *Article) AddTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Tag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"article_tag\" (\"article_id\", \"tag_id\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &articleR{
+			Tags: related,
+		}
+	} else {
+		o.R.Tags = append(o.R.Tags, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tagR{
+				Articles: ArticleSlice{o},
+			}
+		} else {
+			rel.R.Articles = append(rel.R.Articles, o)
+		}
+	}
+	return nil
+}
+
+// SetTagsG removes all previously related items of the
+// article replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Articles's Tags accordingly.
+// Replaces o.R.Tags with related.
+// Sets related.R.Articles's Tags accordingly.
+// Uses the global database handle.
+func (o *Article) SetTagsG(ctx context.Context, insert bool, related ...*Tag) error {
+	return o.SetTags(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetTags removes all previously related items of the
+// article replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Articles's Tags accordingly.
+// Replaces o.R.Tags with related.
+// Sets related.R.Articles's Tags accordingly.
+func (o *Article) SetTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Tag) error {
+	query := "delete from \"article_tag\" where \"article_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeTagsFromArticlesSlice(o, related)
+	if o.R != nil {
+		o.R.Tags = nil
+	}
+
+	return o.AddTags(ctx, exec, insert, related...)
+}
+
+// RemoveTagsG relationships from objects passed in.
+// Removes related items from R.Tags (uses pointer comparison, removal does not keep order)
+// Sets related.R.Articles.
+// Uses the global database handle.
+func (o *Article) RemoveTagsG(ctx context.Context, related ...*Tag) error {
+	return o.RemoveTags(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveTags relationships from objects passed in.
+// Removes related items from R.Tags (uses pointer comparison, removal does not keep order)
+// Sets related.R.Articles.
+func (o *Article) RemoveTags(ctx context.Context, exec boil.ContextExecutor, related ...*Tag) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"article_tag\" where \"article_id\" = $1 and \"tag_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeTagsFromArticlesSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Tags {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Tags)
+			if ln > 1 && i < ln-1 {
+				o.R.Tags[i] = o.R.Tags[ln-1]
+			}
+			o.R.Tags = o.R.Tags[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeTagsFromArticlesSlice(o *Article, related []*Tag) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Articles {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Articles)
+			if ln > 1 && i < ln-1 {
+				rel.R.Articles[i] = rel.R.Articles[ln-1]
+			}
+			rel.R.Articles = rel.R.Articles[:ln-1]
+			break
+		}
+	}
+}
+
 // Articles retrieves all the records using an executor.
 func Articles(mods ...qm.QueryMod) articleQuery {
-	mods = append(mods, qm.From("\"article\""))
+	mods = append(mods, qm.From("\"article\""), qmhelper.WhereIsNull("\"article\".\"deleted_at\""))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"\"article\".*"})
@@ -717,7 +1538,7 @@ func FindArticle(ctx context.Context, exec boil.ContextExecutor, iD int, selectC
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"article\" where \"id\"=$1", sel,
+		"select %s from \"article\" where \"id\"=$1 and \"deleted_at\" is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -756,6 +1577,9 @@ func (o *Article) Insert(ctx context.Context, exec boil.ContextExecutor, columns
 		if queries.MustTime(o.CreatedAt).IsZero() {
 			queries.SetScanner(&o.CreatedAt, currTime)
 		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
 	}
 
 	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
@@ -828,6 +1652,24 @@ func (o *Article) Insert(ctx context.Context, exec boil.ContextExecutor, columns
 	return o.doAfterInsertHooks(ctx, exec)
 }
 
+// articleLockColumns are the primary key and version, they match rows as they were loaded.
+var articleLockColumns = append(append([]string{}, articlePrimaryKeyColumns...), "version")
+
+// articleTouchUpdatedAt returns cols with updated_at set to the current time, cols is not modified.
+// cols is returned as it is if it sets updated_at already or timestamps are skipped.
+func articleTouchUpdatedAt(ctx context.Context, cols M) M {
+	if _, ok := cols["updated_at"]; ok || boil.TimestampsAreSkipped(ctx) {
+		return cols
+	}
+
+	touched := make(M, len(cols)+1)
+	for name, value := range cols {
+		touched[name] = value
+	}
+	touched["updated_at"] = time.Now().In(boil.GetLocation())
+	return touched
+}
+
 // UpdateG a single Article record using the global executor.
 // See Update for more documentation.
 func (o *Article) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
@@ -837,11 +1679,26 @@ func (o *Article) UpdateG(ctx context.Context, columns boil.Columns) (int64, err
 // Update uses an executor to update the Article.
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+// Update only writes the row if its version is still o.Version, and increments o.Version.
+// It returns a *StaleObjectError if the row was changed or deleted in the meantime.
+// updated_at is written even if columns is a whitelist without it, unless timestamps are skipped.
 func (o *Article) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
 	var err error
+	ctx = withWrite(ctx)
 	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
 		return 0, err
 	}
+
+	if !boil.TimestampsAreSkipped(ctx) && columns.IsWhitelist() && !strmangle.SetInclude("updated_at", columns.Cols) {
+		columns = boil.Whitelist(strmangle.SetMerge(columns.Cols, []string{"updated_at"})...)
+	}
+
 	key := makeCacheKey(columns, nil)
 	articleUpdateCacheMut.RLock()
 	cache, cached := articleUpdateCache[key]
@@ -856,15 +1713,16 @@ func (o *Article) Update(ctx context.Context, exec boil.ContextExecutor, columns
 		if !columns.IsWhitelist() {
 			wl = strmangle.SetComplement(wl, []string{"created_at"})
 		}
+		wl = strmangle.SetComplement(wl, []string{"version"})
 		if len(wl) == 0 {
 			return 0, errors.New("dbmodels: unable to update article, could not build whitelist")
 		}
 
 		cache.query = fmt.Sprintf("UPDATE \"article\" SET %s WHERE %s",
 			strmangle.SetParamNames("\"", "\"", 1, wl),
-			strmangle.WhereClause("\"", "\"", len(wl)+1, articlePrimaryKeyColumns),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, articleLockColumns),
 		)
-		cache.valueMapping, err = queries.BindMapping(articleType, articleMapping, append(wl, articlePrimaryKeyColumns...))
+		cache.valueMapping, err = queries.BindMapping(articleType, articleMapping, append(wl, articleLockColumns...))
 		if err != nil {
 			return 0, err
 		}
@@ -888,6 +1746,11 @@ func (o *Article) Update(ctx context.Context, exec boil.ContextExecutor, columns
 		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for article")
 	}
 
+	if rowsAff == 0 {
+		return 0, &StaleObjectError{Table: "article", Rows: 1}
+	}
+	o.Version++
+
 	if !cached {
 		articleUpdateCacheMut.Lock()
 		articleUpdateCache[key] = cache
@@ -903,7 +1766,9 @@ func (q articleQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
 }
 
 // UpdateAll updates all rows with the specified column values.
+// updated_at is set to the current time unless cols sets it or timestamps are skipped.
 func (q articleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	cols = articleTouchUpdatedAt(ctx, cols)
 	queries.SetUpdate(q.Query, cols)
 
 	result, err := q.Query.ExecContext(ctx, exec)
@@ -925,6 +1790,10 @@ func (o ArticleSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
 }
 
 // UpdateAll updates all rows with the specified column values, using an executor.
+// UpdateAll only writes the rows whose version is still the one in the slice, and increments
+// the versions in the slice. If any row was changed or deleted in the meantime, it returns a
+// *StaleObjectError and leaves the versions alone, the other rows are written nonetheless.
+// updated_at is set to the current time unless cols sets it or timestamps are skipped.
 func (o ArticleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
 	ln := int64(len(o))
 	if ln == 0 {
@@ -935,6 +1804,8 @@ func (o ArticleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 		return 0, errors.New("dbmodels: update all requires at least one column argument")
 	}
 
+	cols = articleTouchUpdatedAt(ctx, cols)
+
 	colNames := make([]string, len(cols))
 	args := make([]interface{}, len(cols))
 
@@ -949,11 +1820,12 @@ func (o ArticleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 	for _, obj := range o {
 		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articlePrimaryKeyMapping)
 		args = append(args, pkeyArgs...)
+		args = append(args, obj.Version)
 	}
 
 	sql := fmt.Sprintf("UPDATE \"article\" SET %s WHERE %s",
 		strmangle.SetParamNames("\"", "\"", 1, colNames),
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, articlePrimaryKeyColumns, len(o)))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, articleLockColumns, len(o)))
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -969,6 +1841,13 @@ func (o ArticleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor,
 	if err != nil {
 		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all article")
 	}
+
+	if rowsAff != ln {
+		return rowsAff, &StaleObjectError{Table: "article", Rows: int(ln - rowsAff)}
+	}
+	for _, obj := range o {
+		obj.Version++
+	}
 	return rowsAff, nil
 }
 
@@ -979,6 +1858,10 @@ func (o *Article) UpsertG(ctx context.Context, updateOnConflict bool, conflictCo
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+// The version of o is always inserted. An update only happens if the conflicting row still
+// has that version, o.Version is set to the version of the row afterwards. Upsert returns
+// a *StaleObjectError if the row was changed in the meantime.
+// On conflict, updated_at is updated even if updateColumns is a whitelist without it, unless timestamps are skipped.
 func (o *Article) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
 	if o == nil {
 		return errors.New("dbmodels: no article provided for upsert")
@@ -989,12 +1872,18 @@ func (o *Article) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 		if queries.MustTime(o.CreatedAt).IsZero() {
 			queries.SetScanner(&o.CreatedAt, currTime)
 		}
+		o.UpdatedAt = currTime
 	}
 
+	ctx = withWrite(ctx)
 	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
 		return err
 	}
 
+	if !boil.TimestampsAreSkipped(ctx) && updateColumns.IsWhitelist() && !strmangle.SetInclude("updated_at", updateColumns.Cols) {
+		updateColumns = boil.Whitelist(strmangle.SetMerge(updateColumns.Cols, []string{"updated_at"})...)
+	}
+
 	nzDefaults := queries.NonZeroDefaultSet(articleColumnsWithDefault, o)
 
 	// Build cache key in-line uglily - mysql vs psql problems
@@ -1044,6 +1933,10 @@ func (o *Article) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 			articlePrimaryKeyColumns,
 		)
 
+		insert = strmangle.SetMerge(insert, []string{"version"})
+		ret = strmangle.SetMerge(ret, []string{"version"})
+		update = strmangle.SetComplement(update, []string{"version"})
+
 		if updateOnConflict && len(update) == 0 {
 			return errors.New("dbmodels: unable to upsert article, could not build update column list")
 		}
@@ -1053,7 +1946,11 @@ func (o *Article) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 			conflict = make([]string, len(articlePrimaryKeyColumns))
 			copy(conflict, articlePrimaryKeyColumns)
 		}
-		cache.query = buildUpsertQueryPostgres(dialect, "\"article\"", updateOnConflict, ret, update, conflict, insert)
+		cache.query = buildUpsertQueryPostgres(dialect, "\"article\"", updateOnConflict, nil, update, conflict, insert)
+		if updateOnConflict {
+			cache.query += " WHERE \"article\".\"version\" = EXCLUDED.\"version\""
+		}
+		cache.query += " RETURNING " + strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ", ")
 
 		cache.valueMapping, err = queries.BindMapping(articleType, articleMapping, insert)
 		if err != nil {
@@ -1081,6 +1978,9 @@ func (o *Article) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 	}
 	if len(cache.retMapping) != 0 {
 		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) && updateOnConflict {
+			return &StaleObjectError{Table: "article", Rows: 1}
+		}
 		if errors.Is(err, sql.ErrNoRows) {
 			err = nil // Postgres doesn't return anything when there's no update
 		}
@@ -1102,23 +2002,50 @@ func (o *Article) Upsert(ctx context.Context, exec boil.ContextExecutor, updateO
 
 // DeleteG deletes a single Article record.
 // DeleteG will match against the primary key column to find the record to delete.
-func (o *Article) DeleteG(ctx context.Context) (int64, error) {
-	return o.Delete(ctx, boil.GetContextDB())
+func (o *Article) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // Delete deletes a single Article record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Article) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+// A soft delete also sets updated_at, unless timestamps are skipped.
+// A soft delete only writes the row if its version is still o.Version, and increments o.Version.
+// It returns a *StaleObjectError if the row was changed or deleted in the meantime.
+func (o *Article) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("dbmodels: no Article provided for delete")
 	}
 
+	ctx = withWrite(ctx)
 	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), articlePrimaryKeyMapping)
-	sql := "DELETE FROM \"article\" WHERE \"id\"=$1"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), articlePrimaryKeyMapping)
+		sql = "DELETE FROM \"article\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		if !boil.TimestampsAreSkipped(ctx) {
+			o.UpdatedAt = currTime
+			wl = append(wl, "updated_at")
+		}
+		sql = fmt.Sprintf("UPDATE \"article\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, articleLockColumns),
+		)
+		valueMapping, err := queries.BindMapping(articleType, articleMapping, append(wl, articleLockColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1135,6 +2062,13 @@ func (o *Article) Delete(ctx context.Context, exec boil.ContextExecutor) (int64,
 		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for article")
 	}
 
+	if !hardDelete {
+		if rowsAff == 0 {
+			return 0, &StaleObjectError{Table: "article", Rows: 1}
+		}
+		o.Version++
+	}
+
 	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
 		return 0, err
 	}
@@ -1142,17 +2076,22 @@ func (o *Article) Delete(ctx context.Context, exec boil.ContextExecutor) (int64,
 	return rowsAff, nil
 }
 
-func (q articleQuery) DeleteAllG(ctx context.Context) (int64, error) {
-	return q.DeleteAll(ctx, boil.GetContextDB())
+func (q articleQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // DeleteAll deletes all matching rows.
-func (q articleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q articleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("dbmodels: no articleQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, articleTouchUpdatedAt(ctx, M{"deleted_at": currTime}))
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1168,16 +2107,21 @@ func (q articleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor)
 }
 
 // DeleteAllG deletes all rows in the slice.
-func (o ArticleSlice) DeleteAllG(ctx context.Context) (int64, error) {
-	return o.DeleteAll(ctx, boil.GetContextDB())
+func (o ArticleSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o ArticleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+// A soft delete also sets updated_at, unless timestamps are skipped.
+// A soft delete only writes the rows whose version is still the one in the slice, and increments
+// the versions in the slice. If any row was changed or deleted in the meantime, it returns a
+// *StaleObjectError and leaves the versions alone, the other rows are deleted nonetheless.
+func (o ArticleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
 
+	ctx = withWrite(ctx)
 	if len(articleBeforeDeleteHooks) != 0 {
 		for _, obj := range o {
 			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
@@ -1186,15 +2130,42 @@ func (o ArticleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor)
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articlePrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articlePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"article\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articlePrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		wl := []string{"deleted_at"}
+		set := []interface{}{currTime}
+		touch := !boil.TimestampsAreSkipped(ctx)
+		if touch {
+			wl = append(wl, "updated_at")
+			set = append(set, currTime)
+		}
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articlePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			args = append(args, obj.Version)
+			obj.DeletedAt = null.TimeFrom(currTime)
+			if touch {
+				obj.UpdatedAt = currTime
+			}
+		}
+		sql = fmt.Sprintf("UPDATE \"article\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(wl)+1, articleLockColumns, len(o)),
+		)
+		args = append(set, args...)
 	}
 
-	sql := "DELETE FROM \"article\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articlePrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1210,6 +2181,15 @@ func (o ArticleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor)
 		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for article")
 	}
 
+	if !hardDelete {
+		if ln := int64(len(o)); rowsAff != ln {
+			return rowsAff, &StaleObjectError{Table: "article", Rows: int(ln - rowsAff)}
+		}
+		for _, obj := range o {
+			obj.Version++
+		}
+	}
+
 	if len(articleAfterDeleteHooks) != 0 {
 		for _, obj := range o {
 			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
@@ -1267,7 +2247,8 @@ func (o *ArticleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor)
 	}
 
 	sql := "SELECT \"article\".* FROM \"article\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articlePrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articlePrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1289,7 +2270,7 @@ func ArticleExistsG(ctx context.Context, iD int) (bool, error) {
 // ArticleExists checks if the Article row exists.
 func ArticleExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"article\" where \"id\"=$1 limit 1)"
+	sql := "select exists(select 1 from \"article\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -0,0 +1,1428 @@
+// Code generated by SQLBoiler 4.12.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ArticleAuthor is an object representing the database table.
+type ArticleAuthor struct {
+	ArticleID int               `boil:"article_id" json:"article_id" toml:"article_id" yaml:"article_id"`
+	AuthorID  int               `boil:"author_id" json:"author_id" toml:"author_id" yaml:"author_id"`
+	Role      ArticleAuthorRole `boil:"role" json:"role" toml:"role" yaml:"role"`
+	Position  int               `boil:"position" json:"position" toml:"position" yaml:"position"`
+
+	R *articleAuthorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L articleAuthorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ArticleAuthorColumns = struct {
+	ArticleID string
+	AuthorID  string
+	Role      string
+	Position  string
+}{
+	ArticleID: "article_id",
+	AuthorID:  "author_id",
+	Role:      "role",
+	Position:  "position",
+}
+
+var ArticleAuthorTableColumns = struct {
+	ArticleID string
+	AuthorID  string
+	Role      string
+	Position  string
+}{
+	ArticleID: "article_author.article_id",
+	AuthorID:  "article_author.author_id",
+	Role:      "article_author.role",
+	Position:  "article_author.position",
+}
+
+// Generated where
+
+type whereHelperArticleAuthorRole struct{ field string }
+
+func (w whereHelperArticleAuthorRole) EQ(x ArticleAuthorRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperArticleAuthorRole) NEQ(x ArticleAuthorRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperArticleAuthorRole) LT(x ArticleAuthorRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperArticleAuthorRole) LTE(x ArticleAuthorRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperArticleAuthorRole) GT(x ArticleAuthorRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperArticleAuthorRole) GTE(x ArticleAuthorRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperArticleAuthorRole) IN(slice []ArticleAuthorRole) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperArticleAuthorRole) NIN(slice []ArticleAuthorRole) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ArticleAuthorWhere = struct {
+	ArticleID whereHelperint
+	AuthorID  whereHelperint
+	Role      whereHelperArticleAuthorRole
+	Position  whereHelperint
+}{
+	ArticleID: whereHelperint{field: "\"article_author\".\"article_id\""},
+	AuthorID:  whereHelperint{field: "\"article_author\".\"author_id\""},
+	Role:      whereHelperArticleAuthorRole{field: "\"article_author\".\"role\""},
+	Position:  whereHelperint{field: "\"article_author\".\"position\""},
+}
+
+// ArticleAuthorRels is where relationship names are stored.
+var ArticleAuthorRels = struct {
+	Article string
+	Author  string
+}{
+	Article: "Article",
+	Author:  "Author",
+}
+
+// articleAuthorR is where relationships are stored.
+type articleAuthorR struct {
+	Article *Article `boil:"Article" json:"Article" toml:"Article" yaml:"Article"`
+	Author  *Author  `boil:"Author" json:"Author" toml:"Author" yaml:"Author"`
+}
+
+// NewStruct creates a new relationship struct
+func (*articleAuthorR) NewStruct() *articleAuthorR {
+	return &articleAuthorR{}
+}
+
+func (r *articleAuthorR) GetArticle() *Article {
+	if r == nil {
+		return nil
+	}
+	return r.Article
+}
+
+func (r *articleAuthorR) GetAuthor() *Author {
+	if r == nil {
+		return nil
+	}
+	return r.Author
+}
+
+// articleAuthorL is where Load methods for each relationship are stored.
+type articleAuthorL struct{}
+
+var (
+	articleAuthorAllColumns            = []string{"article_id", "author_id", "role", "position"}
+	articleAuthorColumnsWithoutDefault = []string{"article_id", "author_id", "role"}
+	articleAuthorColumnsWithDefault    = []string{"position"}
+	articleAuthorPrimaryKeyColumns     = []string{"article_id", "author_id"}
+	articleAuthorGeneratedColumns      = []string{}
+)
+
+type (
+	// ArticleAuthorSlice is an alias for a slice of pointers to ArticleAuthor.
+	// This should almost always be used instead of []ArticleAuthor.
+	ArticleAuthorSlice []*ArticleAuthor
+	// ArticleAuthorHook is the signature for custom ArticleAuthor hook methods
+	ArticleAuthorHook func(context.Context, boil.ContextExecutor, *ArticleAuthor) error
+
+	articleAuthorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	articleAuthorType                 = reflect.TypeOf(&ArticleAuthor{})
+	articleAuthorMapping              = queries.MakeStructMapping(articleAuthorType)
+	articleAuthorPrimaryKeyMapping, _ = queries.BindMapping(articleAuthorType, articleAuthorMapping, articleAuthorPrimaryKeyColumns)
+	articleAuthorInsertCacheMut       sync.RWMutex
+	articleAuthorInsertCache          = make(map[string]insertCache)
+	articleAuthorUpdateCacheMut       sync.RWMutex
+	articleAuthorUpdateCache          = make(map[string]updateCache)
+	articleAuthorUpsertCacheMut       sync.RWMutex
+	articleAuthorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var articleAuthorAfterSelectHooks []ArticleAuthorHook
+
+var articleAuthorBeforeInsertHooks []ArticleAuthorHook
+var articleAuthorAfterInsertHooks []ArticleAuthorHook
+
+var articleAuthorBeforeUpdateHooks []ArticleAuthorHook
+var articleAuthorAfterUpdateHooks []ArticleAuthorHook
+
+var articleAuthorBeforeDeleteHooks []ArticleAuthorHook
+var articleAuthorAfterDeleteHooks []ArticleAuthorHook
+
+var articleAuthorBeforeUpsertHooks []ArticleAuthorHook
+var articleAuthorAfterUpsertHooks []ArticleAuthorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ArticleAuthor) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ArticleAuthor) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ArticleAuthor) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ArticleAuthor) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ArticleAuthor) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ArticleAuthor) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ArticleAuthor) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ArticleAuthor) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ArticleAuthor) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleAuthorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddArticleAuthorHook registers your hook function for all future operations.
+func AddArticleAuthorHook(hookPoint boil.HookPoint, articleAuthorHook ArticleAuthorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		articleAuthorAfterSelectHooks = append(articleAuthorAfterSelectHooks, articleAuthorHook)
+	case boil.BeforeInsertHook:
+		articleAuthorBeforeInsertHooks = append(articleAuthorBeforeInsertHooks, articleAuthorHook)
+	case boil.AfterInsertHook:
+		articleAuthorAfterInsertHooks = append(articleAuthorAfterInsertHooks, articleAuthorHook)
+	case boil.BeforeUpdateHook:
+		articleAuthorBeforeUpdateHooks = append(articleAuthorBeforeUpdateHooks, articleAuthorHook)
+	case boil.AfterUpdateHook:
+		articleAuthorAfterUpdateHooks = append(articleAuthorAfterUpdateHooks, articleAuthorHook)
+	case boil.BeforeDeleteHook:
+		articleAuthorBeforeDeleteHooks = append(articleAuthorBeforeDeleteHooks, articleAuthorHook)
+	case boil.AfterDeleteHook:
+		articleAuthorAfterDeleteHooks = append(articleAuthorAfterDeleteHooks, articleAuthorHook)
+	case boil.BeforeUpsertHook:
+		articleAuthorBeforeUpsertHooks = append(articleAuthorBeforeUpsertHooks, articleAuthorHook)
+	case boil.AfterUpsertHook:
+		articleAuthorAfterUpsertHooks = append(articleAuthorAfterUpsertHooks, articleAuthorHook)
+	}
+}
+
+// OneG returns a single articleAuthor record from the query using the global executor.
+func (q articleAuthorQuery) OneG(ctx context.Context) (*ArticleAuthor, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single articleAuthor record from the query.
+func (q articleAuthorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ArticleAuthor, error) {
+	o := &ArticleAuthor{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for article_author")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ArticleAuthor records from the query using the global executor.
+func (q articleAuthorQuery) AllG(ctx context.Context) (ArticleAuthorSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ArticleAuthor records from the query.
+func (q articleAuthorQuery) All(ctx context.Context, exec boil.ContextExecutor) (ArticleAuthorSlice, error) {
+	var o []*ArticleAuthor
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to ArticleAuthor slice")
+	}
+
+	if len(articleAuthorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ArticleAuthor records in the query using the global executor
+func (q articleAuthorQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ArticleAuthor records in the query.
+func (q articleAuthorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count article_author rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q articleAuthorQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q articleAuthorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if article_author exists")
+	}
+
+	return count > 0, nil
+}
+
+// Article pointed to by the foreign key.
+func (o *ArticleAuthor) Article(mods ...qm.QueryMod) articleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ArticleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Articles(queryMods...)
+}
+
+// Author pointed to by the foreign key.
+func (o *ArticleAuthor) Author(mods ...qm.QueryMod) authorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AuthorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Authors(queryMods...)
+}
+
+// LoadArticle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleAuthorL) LoadArticle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticleAuthor interface{}, mods queries.Applicator) error {
+	var slice []*ArticleAuthor
+	var object *ArticleAuthor
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticleAuthor.(*ArticleAuthor)
+		if !ok {
+			object = new(ArticleAuthor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticleAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticleAuthor))
+			}
+		}
+	} else {
+		s, ok := maybeArticleAuthor.(*[]*ArticleAuthor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticleAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticleAuthor))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleAuthorR{}
+		}
+		args = append(args, object.ArticleID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleAuthorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ArticleID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ArticleID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`article`),
+		qm.WhereIn(`article.id in ?`, args...),
+		qmhelper.WhereIsNull(`article.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Article")
+	}
+
+	var resultSlice []*Article
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Article")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for article")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article")
+	}
+
+	if len(articleAuthorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Article = foreign
+		if foreign.R == nil {
+			foreign.R = &articleR{}
+		}
+		foreign.R.ArticleAuthors = append(foreign.R.ArticleAuthors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ArticleID == foreign.ID {
+				local.R.Article = foreign
+				if foreign.R == nil {
+					foreign.R = &articleR{}
+				}
+				foreign.R.ArticleAuthors = append(foreign.R.ArticleAuthors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleAuthorL) LoadAuthor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticleAuthor interface{}, mods queries.Applicator) error {
+	var slice []*ArticleAuthor
+	var object *ArticleAuthor
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticleAuthor.(*ArticleAuthor)
+		if !ok {
+			object = new(ArticleAuthor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticleAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticleAuthor))
+			}
+		}
+	} else {
+		s, ok := maybeArticleAuthor.(*[]*ArticleAuthor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticleAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticleAuthor))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleAuthorR{}
+		}
+		args = append(args, object.AuthorID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleAuthorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AuthorID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AuthorID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`author`),
+		qm.WhereIn(`author.id in ?`, args...),
+		qmhelper.WhereIsNull(`author.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Author")
+	}
+
+	var resultSlice []*Author
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Author")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for author")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for author")
+	}
+
+	if len(articleAuthorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Author = foreign
+		if foreign.R == nil {
+			foreign.R = &authorR{}
+		}
+		foreign.R.ArticleAuthors = append(foreign.R.ArticleAuthors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AuthorID == foreign.ID {
+				local.R.Author = foreign
+				if foreign.R == nil {
+					foreign.R = &authorR{}
+				}
+				foreign.R.ArticleAuthors = append(foreign.R.ArticleAuthors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArticleG of the articleAuthor to the related item.
+// Sets o.R.Article to related.
+// Adds o to related.R.ArticleAuthors.
+// Uses the global database handle.
+func (o *ArticleAuthor) SetArticleG(ctx context.Context, insert bool, related *Article) error {
+	return o.SetArticle(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetArticle of the articleAuthor to the related item.
+// Sets o.R.Article to related.
+// Adds o to related.R.ArticleAuthors.
+func (o *ArticleAuthor) SetArticle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Article) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"article_author\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"article_id"}),
+		strmangle.WhereClause("\"", "\"", 2, articleAuthorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ArticleID, o.AuthorID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ArticleID = related.ID
+	if o.R == nil {
+		o.R = &articleAuthorR{
+			Article: related,
+		}
+	} else {
+		o.R.Article = related
+	}
+
+	if related.R == nil {
+		related.R = &articleR{
+			ArticleAuthors: ArticleAuthorSlice{o},
+		}
+	} else {
+		related.R.ArticleAuthors = append(related.R.ArticleAuthors, o)
+	}
+
+	return nil
+}
+
+// SetAuthorG of the articleAuthor to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.ArticleAuthors.
+// Uses the global database handle.
+func (o *ArticleAuthor) SetAuthorG(ctx context.Context, insert bool, related *Author) error {
+	return o.SetAuthor(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAuthor of the articleAuthor to the related item.
+// Sets o.R.Author to related.
+// Adds o to related.R.ArticleAuthors.
+func (o *ArticleAuthor) SetAuthor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Author) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"article_author\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
+		strmangle.WhereClause("\"", "\"", 2, articleAuthorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ArticleID, o.AuthorID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AuthorID = related.ID
+	if o.R == nil {
+		o.R = &articleAuthorR{
+			Author: related,
+		}
+	} else {
+		o.R.Author = related
+	}
+
+	if related.R == nil {
+		related.R = &authorR{
+			ArticleAuthors: ArticleAuthorSlice{o},
+		}
+	} else {
+		related.R.ArticleAuthors = append(related.R.ArticleAuthors, o)
+	}
+
+	return nil
+}
+
+// ArticleAuthors retrieves all the records using an executor.
+func ArticleAuthors(mods ...qm.QueryMod) articleAuthorQuery {
+	mods = append(mods, qm.From("\"article_author\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"article_author\".*"})
+	}
+
+	return articleAuthorQuery{q}
+}
+
+// FindArticleAuthorG retrieves a single record by ID.
+func FindArticleAuthorG(ctx context.Context, articleID int, authorID int, selectCols ...string) (*ArticleAuthor, error) {
+	return FindArticleAuthor(ctx, boil.GetContextDB(), articleID, authorID, selectCols...)
+}
+
+// FindArticleAuthor retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindArticleAuthor(ctx context.Context, exec boil.ContextExecutor, articleID int, authorID int, selectCols ...string) (*ArticleAuthor, error) {
+	articleAuthorObj := &ArticleAuthor{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"article_author\" where \"article_id\"=$1 AND \"author_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, articleID, authorID)
+
+	err := q.Bind(ctx, exec, articleAuthorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from article_author")
+	}
+
+	if err = articleAuthorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return articleAuthorObj, err
+	}
+
+	return articleAuthorObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ArticleAuthor) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ArticleAuthor) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no article_author provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleAuthorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	articleAuthorInsertCacheMut.RLock()
+	cache, cached := articleAuthorInsertCache[key]
+	articleAuthorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			articleAuthorAllColumns,
+			articleAuthorColumnsWithDefault,
+			articleAuthorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"article_author\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"article_author\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into article_author")
+	}
+
+	if !cached {
+		articleAuthorInsertCacheMut.Lock()
+		articleAuthorInsertCache[key] = cache
+		articleAuthorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ArticleAuthor record using the global executor.
+// See Update for more documentation.
+func (o *ArticleAuthor) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ArticleAuthor.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ArticleAuthor) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	ctx = withWrite(ctx)
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	articleAuthorUpdateCacheMut.RLock()
+	cache, cached := articleAuthorUpdateCache[key]
+	articleAuthorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			articleAuthorAllColumns,
+			articleAuthorPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update article_author, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"article_author\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, articleAuthorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, append(wl, articleAuthorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update article_author row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for article_author")
+	}
+
+	if !cached {
+		articleAuthorUpdateCacheMut.Lock()
+		articleAuthorUpdateCache[key] = cache
+		articleAuthorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q articleAuthorQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q articleAuthorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for article_author")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for article_author")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ArticleAuthorSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ArticleAuthorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleAuthorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"article_author\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, articleAuthorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in articleAuthor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all articleAuthor")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ArticleAuthor) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ArticleAuthor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no article_author provided for upsert")
+	}
+
+	ctx = withWrite(ctx)
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleAuthorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	articleAuthorUpsertCacheMut.RLock()
+	cache, cached := articleAuthorUpsertCache[key]
+	articleAuthorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			articleAuthorAllColumns,
+			articleAuthorColumnsWithDefault,
+			articleAuthorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			articleAuthorAllColumns,
+			articleAuthorPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert article_author, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(articleAuthorPrimaryKeyColumns))
+			copy(conflict, articleAuthorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"article_author\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(articleAuthorType, articleAuthorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert article_author")
+	}
+
+	if !cached {
+		articleAuthorUpsertCacheMut.Lock()
+		articleAuthorUpsertCache[key] = cache
+		articleAuthorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ArticleAuthor record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ArticleAuthor) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ArticleAuthor record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ArticleAuthor) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no ArticleAuthor provided for delete")
+	}
+
+	ctx = withWrite(ctx)
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), articleAuthorPrimaryKeyMapping)
+	sql := "DELETE FROM \"article_author\" WHERE \"article_id\"=$1 AND \"author_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from article_author")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for article_author")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q articleAuthorQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q articleAuthorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no articleAuthorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from article_author")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for article_author")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ArticleAuthorSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ArticleAuthorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	ctx = withWrite(ctx)
+	if len(articleAuthorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleAuthorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"article_author\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articleAuthorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from articleAuthor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for article_author")
+	}
+
+	if len(articleAuthorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ArticleAuthor) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodels: no ArticleAuthor provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ArticleAuthor) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindArticleAuthor(ctx, exec, o.ArticleID, o.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ArticleAuthorSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodels: empty ArticleAuthorSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ArticleAuthorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ArticleAuthorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleAuthorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"article_author\".* FROM \"article_author\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articleAuthorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in ArticleAuthorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ArticleAuthorExistsG checks if the ArticleAuthor row exists.
+func ArticleAuthorExistsG(ctx context.Context, articleID int, authorID int) (bool, error) {
+	return ArticleAuthorExists(ctx, boil.GetContextDB(), articleID, authorID)
+}
+
+// ArticleAuthorExists checks if the ArticleAuthor row exists.
+func ArticleAuthorExists(ctx context.Context, exec boil.ContextExecutor, articleID int, authorID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"article_author\" where \"article_id\"=$1 AND \"author_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, articleID, authorID)
+	}
+	row := exec.QueryRowContext(ctx, sql, articleID, authorID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if article_author exists")
+	}
+
+	return exists, nil
+}
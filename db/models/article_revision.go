@@ -0,0 +1,1254 @@
+// Code generated by SQLBoiler 4.12.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ArticleRevision is an object representing the database table.
+type ArticleRevision struct {
+	ArticleID int         `boil:"article_id" json:"article_id" toml:"article_id" yaml:"article_id"`
+	Version   int         `boil:"version" json:"version" toml:"version" yaml:"version"`
+	Title     string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Body      null.String `boil:"body" json:"body,omitempty" toml:"body" yaml:"body,omitempty"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *articleRevisionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L articleRevisionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ArticleRevisionColumns = struct {
+	ArticleID string
+	Version   string
+	Title     string
+	Body      string
+	CreatedAt string
+}{
+	ArticleID: "article_id",
+	Version:   "version",
+	Title:     "title",
+	Body:      "body",
+	CreatedAt: "created_at",
+}
+
+var ArticleRevisionTableColumns = struct {
+	ArticleID string
+	Version   string
+	Title     string
+	Body      string
+	CreatedAt string
+}{
+	ArticleID: "article_revision.article_id",
+	Version:   "article_revision.version",
+	Title:     "article_revision.title",
+	Body:      "article_revision.body",
+	CreatedAt: "article_revision.created_at",
+}
+
+// Generated where
+
+var ArticleRevisionWhere = struct {
+	ArticleID whereHelperint
+	Version   whereHelperint
+	Title     whereHelperstring
+	Body      whereHelpernull_String
+	CreatedAt whereHelpertime_Time
+}{
+	ArticleID: whereHelperint{field: "\"article_revision\".\"article_id\""},
+	Version:   whereHelperint{field: "\"article_revision\".\"version\""},
+	Title:     whereHelperstring{field: "\"article_revision\".\"title\""},
+	Body:      whereHelpernull_String{field: "\"article_revision\".\"body\""},
+	CreatedAt: whereHelpertime_Time{field: "\"article_revision\".\"created_at\""},
+}
+
+// ArticleRevisionRels is where relationship names are stored.
+var ArticleRevisionRels = struct {
+	Article string
+}{
+	Article: "Article",
+}
+
+// articleRevisionR is where relationships are stored.
+type articleRevisionR struct {
+	Article *Article `boil:"Article" json:"Article" toml:"Article" yaml:"Article"`
+}
+
+// NewStruct creates a new relationship struct
+func (*articleRevisionR) NewStruct() *articleRevisionR {
+	return &articleRevisionR{}
+}
+
+func (r *articleRevisionR) GetArticle() *Article {
+	if r == nil {
+		return nil
+	}
+	return r.Article
+}
+
+// articleRevisionL is where Load methods for each relationship are stored.
+type articleRevisionL struct{}
+
+var (
+	articleRevisionAllColumns            = []string{"article_id", "version", "title", "body", "created_at"}
+	articleRevisionColumnsWithoutDefault = []string{"article_id", "version", "title"}
+	articleRevisionColumnsWithDefault    = []string{"body", "created_at"}
+	articleRevisionPrimaryKeyColumns     = []string{"article_id", "version"}
+	articleRevisionGeneratedColumns      = []string{}
+)
+
+type (
+	// ArticleRevisionSlice is an alias for a slice of pointers to ArticleRevision.
+	// This should almost always be used instead of []ArticleRevision.
+	ArticleRevisionSlice []*ArticleRevision
+	// ArticleRevisionHook is the signature for custom ArticleRevision hook methods
+	ArticleRevisionHook func(context.Context, boil.ContextExecutor, *ArticleRevision) error
+
+	articleRevisionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	articleRevisionType                 = reflect.TypeOf(&ArticleRevision{})
+	articleRevisionMapping              = queries.MakeStructMapping(articleRevisionType)
+	articleRevisionPrimaryKeyMapping, _ = queries.BindMapping(articleRevisionType, articleRevisionMapping, articleRevisionPrimaryKeyColumns)
+	articleRevisionInsertCacheMut       sync.RWMutex
+	articleRevisionInsertCache          = make(map[string]insertCache)
+	articleRevisionUpdateCacheMut       sync.RWMutex
+	articleRevisionUpdateCache          = make(map[string]updateCache)
+	articleRevisionUpsertCacheMut       sync.RWMutex
+	articleRevisionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var articleRevisionAfterSelectHooks []ArticleRevisionHook
+
+var articleRevisionBeforeInsertHooks []ArticleRevisionHook
+var articleRevisionAfterInsertHooks []ArticleRevisionHook
+
+var articleRevisionBeforeUpdateHooks []ArticleRevisionHook
+var articleRevisionAfterUpdateHooks []ArticleRevisionHook
+
+var articleRevisionBeforeDeleteHooks []ArticleRevisionHook
+var articleRevisionAfterDeleteHooks []ArticleRevisionHook
+
+var articleRevisionBeforeUpsertHooks []ArticleRevisionHook
+var articleRevisionAfterUpsertHooks []ArticleRevisionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ArticleRevision) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleRevisionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ArticleRevision) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleRevisionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ArticleRevision) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleRevisionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ArticleRevision) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleRevisionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ArticleRevision) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleRevisionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ArticleRevision) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleRevisionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ArticleRevision) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleRevisionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ArticleRevision) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleRevisionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ArticleRevision) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range articleRevisionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddArticleRevisionHook registers your hook function for all future operations.
+func AddArticleRevisionHook(hookPoint boil.HookPoint, articleRevisionHook ArticleRevisionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		articleRevisionAfterSelectHooks = append(articleRevisionAfterSelectHooks, articleRevisionHook)
+	case boil.BeforeInsertHook:
+		articleRevisionBeforeInsertHooks = append(articleRevisionBeforeInsertHooks, articleRevisionHook)
+	case boil.AfterInsertHook:
+		articleRevisionAfterInsertHooks = append(articleRevisionAfterInsertHooks, articleRevisionHook)
+	case boil.BeforeUpdateHook:
+		articleRevisionBeforeUpdateHooks = append(articleRevisionBeforeUpdateHooks, articleRevisionHook)
+	case boil.AfterUpdateHook:
+		articleRevisionAfterUpdateHooks = append(articleRevisionAfterUpdateHooks, articleRevisionHook)
+	case boil.BeforeDeleteHook:
+		articleRevisionBeforeDeleteHooks = append(articleRevisionBeforeDeleteHooks, articleRevisionHook)
+	case boil.AfterDeleteHook:
+		articleRevisionAfterDeleteHooks = append(articleRevisionAfterDeleteHooks, articleRevisionHook)
+	case boil.BeforeUpsertHook:
+		articleRevisionBeforeUpsertHooks = append(articleRevisionBeforeUpsertHooks, articleRevisionHook)
+	case boil.AfterUpsertHook:
+		articleRevisionAfterUpsertHooks = append(articleRevisionAfterUpsertHooks, articleRevisionHook)
+	}
+}
+
+// OneG returns a single articleRevision record from the query using the global executor.
+func (q articleRevisionQuery) OneG(ctx context.Context) (*ArticleRevision, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single articleRevision record from the query.
+func (q articleRevisionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ArticleRevision, error) {
+	o := &ArticleRevision{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for article_revision")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ArticleRevision records from the query using the global executor.
+func (q articleRevisionQuery) AllG(ctx context.Context) (ArticleRevisionSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ArticleRevision records from the query.
+func (q articleRevisionQuery) All(ctx context.Context, exec boil.ContextExecutor) (ArticleRevisionSlice, error) {
+	var o []*ArticleRevision
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to ArticleRevision slice")
+	}
+
+	if len(articleRevisionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ArticleRevision records in the query using the global executor
+func (q articleRevisionQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ArticleRevision records in the query.
+func (q articleRevisionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count article_revision rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q articleRevisionQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q articleRevisionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if article_revision exists")
+	}
+
+	return count > 0, nil
+}
+
+// Article pointed to by the foreign key.
+func (o *ArticleRevision) Article(mods ...qm.QueryMod) articleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ArticleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Articles(queryMods...)
+}
+
+// LoadArticle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (articleRevisionL) LoadArticle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeArticleRevision interface{}, mods queries.Applicator) error {
+	var slice []*ArticleRevision
+	var object *ArticleRevision
+
+	if singular {
+		var ok bool
+		object, ok = maybeArticleRevision.(*ArticleRevision)
+		if !ok {
+			object = new(ArticleRevision)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeArticleRevision)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeArticleRevision))
+			}
+		}
+	} else {
+		s, ok := maybeArticleRevision.(*[]*ArticleRevision)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeArticleRevision)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeArticleRevision))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &articleRevisionR{}
+		}
+		args = append(args, object.ArticleID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &articleRevisionR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ArticleID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ArticleID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`article`),
+		qm.WhereIn(`article.id in ?`, args...),
+		qmhelper.WhereIsNull(`article.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Article")
+	}
+
+	var resultSlice []*Article
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Article")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for article")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article")
+	}
+
+	if len(articleRevisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Article = foreign
+		if foreign.R == nil {
+			foreign.R = &articleR{}
+		}
+		foreign.R.ArticleRevisions = append(foreign.R.ArticleRevisions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ArticleID == foreign.ID {
+				local.R.Article = foreign
+				if foreign.R == nil {
+					foreign.R = &articleR{}
+				}
+				foreign.R.ArticleRevisions = append(foreign.R.ArticleRevisions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArticleG of the articleRevision to the related item.
+// Sets o.R.Article to related.
+// Adds o to related.R.ArticleRevisions.
+// Uses the global database handle.
+func (o *ArticleRevision) SetArticleG(ctx context.Context, insert bool, related *Article) error {
+	return o.SetArticle(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetArticle of the articleRevision to the related item.
+// Sets o.R.Article to related.
+// Adds o to related.R.ArticleRevisions.
+func (o *ArticleRevision) SetArticle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Article) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"article_revision\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"article_id"}),
+		strmangle.WhereClause("\"", "\"", 2, articleRevisionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ArticleID, o.Version}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ArticleID = related.ID
+	if o.R == nil {
+		o.R = &articleRevisionR{
+			Article: related,
+		}
+	} else {
+		o.R.Article = related
+	}
+
+	if related.R == nil {
+		related.R = &articleR{
+			ArticleRevisions: ArticleRevisionSlice{o},
+		}
+	} else {
+		related.R.ArticleRevisions = append(related.R.ArticleRevisions, o)
+	}
+
+	return nil
+}
+
+// ArticleRevisions retrieves all the records using an executor.
+func ArticleRevisions(mods ...qm.QueryMod) articleRevisionQuery {
+	mods = append(mods, qm.From("\"article_revision\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"article_revision\".*"})
+	}
+
+	return articleRevisionQuery{q}
+}
+
+// FindArticleRevisionG retrieves a single record by ID.
+func FindArticleRevisionG(ctx context.Context, articleID int, version int, selectCols ...string) (*ArticleRevision, error) {
+	return FindArticleRevision(ctx, boil.GetContextDB(), articleID, version, selectCols...)
+}
+
+// FindArticleRevision retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindArticleRevision(ctx context.Context, exec boil.ContextExecutor, articleID int, version int, selectCols ...string) (*ArticleRevision, error) {
+	articleRevisionObj := &ArticleRevision{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"article_revision\" where \"article_id\"=$1 AND \"version\"=$2", sel,
+	)
+
+	q := queries.Raw(query, articleID, version)
+
+	err := q.Bind(ctx, exec, articleRevisionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from article_revision")
+	}
+
+	if err = articleRevisionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return articleRevisionObj, err
+	}
+
+	return articleRevisionObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ArticleRevision) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ArticleRevision) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no article_revision provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleRevisionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	articleRevisionInsertCacheMut.RLock()
+	cache, cached := articleRevisionInsertCache[key]
+	articleRevisionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			articleRevisionAllColumns,
+			articleRevisionColumnsWithDefault,
+			articleRevisionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(articleRevisionType, articleRevisionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(articleRevisionType, articleRevisionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"article_revision\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"article_revision\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into article_revision")
+	}
+
+	if !cached {
+		articleRevisionInsertCacheMut.Lock()
+		articleRevisionInsertCache[key] = cache
+		articleRevisionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// articleRevisionLockColumns are the primary key and version, they match rows as they were loaded.
+var articleRevisionLockColumns = append(append([]string{}, articleRevisionPrimaryKeyColumns...), "version")
+
+// UpdateG a single ArticleRevision record using the global executor.
+// See Update for more documentation.
+func (o *ArticleRevision) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ArticleRevision.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+// Update only writes the row if its version is still o.Version, and increments o.Version.
+// It returns a *StaleObjectError if the row was changed or deleted in the meantime.
+func (o *ArticleRevision) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	ctx = withWrite(ctx)
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	articleRevisionUpdateCacheMut.RLock()
+	cache, cached := articleRevisionUpdateCache[key]
+	articleRevisionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			articleRevisionAllColumns,
+			articleRevisionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		wl = strmangle.SetComplement(wl, []string{"version"})
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update article_revision, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"article_revision\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, articleRevisionLockColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(articleRevisionType, articleRevisionMapping, append(wl, articleRevisionLockColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update article_revision row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for article_revision")
+	}
+
+	if rowsAff == 0 {
+		return 0, &StaleObjectError{Table: "article_revision", Rows: 1}
+	}
+	o.Version++
+
+	if !cached {
+		articleRevisionUpdateCacheMut.Lock()
+		articleRevisionUpdateCache[key] = cache
+		articleRevisionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q articleRevisionQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q articleRevisionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for article_revision")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for article_revision")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ArticleRevisionSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+// UpdateAll only writes the rows whose version is still the one in the slice, and increments
+// the versions in the slice. If any row was changed or deleted in the meantime, it returns a
+// *StaleObjectError and leaves the versions alone, the other rows are written nonetheless.
+func (o ArticleRevisionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleRevisionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+		args = append(args, obj.Version)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"article_revision\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, articleRevisionLockColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in articleRevision slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all articleRevision")
+	}
+
+	if rowsAff != ln {
+		return rowsAff, &StaleObjectError{Table: "article_revision", Rows: int(ln - rowsAff)}
+	}
+	for _, obj := range o {
+		obj.Version++
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ArticleRevision) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+// The version of o is always inserted. An update only happens if the conflicting row still
+// has that version, o.Version is set to the version of the row afterwards. Upsert returns
+// a *StaleObjectError if the row was changed in the meantime.
+func (o *ArticleRevision) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no article_revision provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	ctx = withWrite(ctx)
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(articleRevisionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	articleRevisionUpsertCacheMut.RLock()
+	cache, cached := articleRevisionUpsertCache[key]
+	articleRevisionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			articleRevisionAllColumns,
+			articleRevisionColumnsWithDefault,
+			articleRevisionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			articleRevisionAllColumns,
+			articleRevisionPrimaryKeyColumns,
+		)
+
+		insert = strmangle.SetMerge(insert, []string{"version"})
+		ret = strmangle.SetMerge(ret, []string{"version"})
+		update = strmangle.SetComplement(update, []string{"version"})
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert article_revision, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(articleRevisionPrimaryKeyColumns))
+			copy(conflict, articleRevisionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"article_revision\"", updateOnConflict, nil, update, conflict, insert)
+		if updateOnConflict {
+			cache.query += " WHERE \"article_revision\".\"version\" = EXCLUDED.\"version\""
+		}
+		cache.query += " RETURNING " + strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ", ")
+
+		cache.valueMapping, err = queries.BindMapping(articleRevisionType, articleRevisionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(articleRevisionType, articleRevisionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) && updateOnConflict {
+			return &StaleObjectError{Table: "article_revision", Rows: 1}
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert article_revision")
+	}
+
+	if !cached {
+		articleRevisionUpsertCacheMut.Lock()
+		articleRevisionUpsertCache[key] = cache
+		articleRevisionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ArticleRevision record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ArticleRevision) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ArticleRevision record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ArticleRevision) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no ArticleRevision provided for delete")
+	}
+
+	ctx = withWrite(ctx)
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), articleRevisionPrimaryKeyMapping)
+	sql := "DELETE FROM \"article_revision\" WHERE \"article_id\"=$1 AND \"version\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from article_revision")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for article_revision")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q articleRevisionQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q articleRevisionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no articleRevisionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from article_revision")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for article_revision")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ArticleRevisionSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ArticleRevisionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	ctx = withWrite(ctx)
+	if len(articleRevisionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleRevisionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"article_revision\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articleRevisionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from articleRevision slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for article_revision")
+	}
+
+	if len(articleRevisionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ArticleRevision) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodels: no ArticleRevision provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ArticleRevision) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindArticleRevision(ctx, exec, o.ArticleID, o.Version)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ArticleRevisionSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("dbmodels: empty ArticleRevisionSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ArticleRevisionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ArticleRevisionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), articleRevisionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"article_revision\".* FROM \"article_revision\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, articleRevisionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in ArticleRevisionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ArticleRevisionExistsG checks if the ArticleRevision row exists.
+func ArticleRevisionExistsG(ctx context.Context, articleID int, version int) (bool, error) {
+	return ArticleRevisionExists(ctx, boil.GetContextDB(), articleID, version)
+}
+
+// ArticleRevisionExists checks if the ArticleRevision row exists.
+func ArticleRevisionExists(ctx context.Context, exec boil.ContextExecutor, articleID int, version int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"article_revision\" where \"article_id\"=$1 AND \"version\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, articleID, version)
+	}
+	row := exec.QueryRowContext(ctx, sql, articleID, version)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if article_revision exists")
+	}
+
+	return exists, nil
+}
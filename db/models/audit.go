@@ -0,0 +1,226 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/strmangle"
+)
+
+// Inserts, updates, upserts and deletes of authors and articles are
+// recorded in audit_log by their after hooks, using the executor of the
+// write, so a transaction covers both. An entry names the actor of the
+// context, see WithActor, and holds the row as JSON before and after the
+// change, both read from the database: the before hooks read and lock the
+// row and keep it in the context of the write for the after hooks, which
+// read the row back. The lock only holds in a transaction, which the
+// repositories run every write in. Upserts look the row up by id, so
+// before is null for an upsert of a model without one. Purges record the
+// rows they remove themselves, see PurgeArticles. Other writes that skip
+// the hooks, such as UpdateAll, the bulk deletes or a Copy with
+// CopySkipHooks, aren't recorded.
+
+func init() {
+	for point, op := range map[boil.HookPoint]AuditOperation{
+		boil.BeforeUpdateHook: AuditOperationUpdate,
+		boil.BeforeUpsertHook: AuditOperationUpsert,
+		boil.BeforeDeleteHook: AuditOperationDelete,
+	} {
+		AddArticleHook(point, captureArticle(op))
+		AddAuthorHook(point, captureAuthor(op))
+	}
+
+	for point, op := range map[boil.HookPoint]AuditOperation{
+		boil.AfterInsertHook: AuditOperationInsert,
+		boil.AfterUpdateHook: AuditOperationUpdate,
+		boil.AfterUpsertHook: AuditOperationUpsert,
+		boil.AfterDeleteHook: AuditOperationDelete,
+	} {
+		AddArticleHook(point, auditArticle(op))
+		AddAuthorHook(point, auditAuthor(op))
+	}
+}
+
+func captureArticle(op AuditOperation) ArticleHook {
+	return func(ctx context.Context, exec boil.ContextExecutor, o *Article) error {
+		return capture(ctx, exec, articleModelTable, op, o.ID, o)
+	}
+}
+
+func captureAuthor(op AuditOperation) AuthorHook {
+	return func(ctx context.Context, exec boil.ContextExecutor, o *Author) error {
+		return capture(ctx, exec, authorModelTable, op, o.ID, o)
+	}
+}
+
+func auditArticle(op AuditOperation) ArticleHook {
+	return func(ctx context.Context, exec boil.ContextExecutor, o *Article) error {
+		return audit(ctx, exec, articleModelTable, op, o.ID, o)
+	}
+}
+
+func auditAuthor(op AuditOperation) AuthorHook {
+	return func(ctx context.Context, exec boil.ContextExecutor, o *Author) error {
+		return audit(ctx, exec, authorModelTable, op, o.ID, o)
+	}
+}
+
+// beforeKey is the key under which the before hook of a write keeps the
+// row it read for the after hook, see keepForAfterHooks.
+type beforeKey struct{}
+
+// rowQuery returns the query reading the row of table t with the id $1 as
+// JSON, locked for update if lock is set.
+func rowQuery(t modelTable, lock bool) string {
+	query := fmt.Sprintf(`SELECT %s FROM "%s" WHERE "id" = $1`,
+		strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, t.all), ", "), t.name)
+	if lock {
+		query += " FOR UPDATE"
+	}
+	return `(SELECT to_jsonb("row") FROM (` + query + `) AS "row")`
+}
+
+// capture is the before hook reading the row of table t with the given id
+// before op changes it from model, for the after hook of the write to
+// record.
+func capture(ctx context.Context, exec boil.ContextExecutor, t modelTable, op AuditOperation, id int, model interface{}) error {
+	query := "SELECT " + rowQuery(t, true)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, id)
+	}
+
+	var row null.JSON
+	if err := exec.QueryRowContext(ctx, query, id).Scan(&row); err != nil {
+		return errors.Wrapf(err, "dbmodels: unable to read %s %d before %s", t.name, id, op)
+	}
+
+	keepForAfterHooks(ctx, model, beforeKey{}, row)
+	return nil
+}
+
+// actorKey is the context key of the actor set with WithActor.
+type actorKey struct{}
+
+var (
+	defaultActorMu sync.RWMutex
+	defaultActor   string
+)
+
+// SetActor sets the actor of the writes whose context doesn't name one with
+// WithActor, e.g. the user running a command. It is empty by default,
+// which records no actor.
+func SetActor(actor string) {
+	defaultActorMu.Lock()
+	defaultActor = actor
+	defaultActorMu.Unlock()
+}
+
+// WithActor returns a copy of ctx naming the actor of the writes made with
+// it in the audit log, e.g. the user a request is made for. An empty actor
+// records no actor, even if SetActor set one.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns the actor of the writes made with ctx, see WithActor
+// and SetActor, or "" if there is none.
+func ActorFrom(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok {
+		return actor
+	}
+
+	defaultActorMu.RLock()
+	defer defaultActorMu.RUnlock()
+	return defaultActor
+}
+
+// auditQuery returns the statement recording a change of a row of table
+// t, with the actor, operation, table, id and before image of the row as
+// parameters.
+func auditQuery(t modelTable) string {
+	return `INSERT INTO "audit_log" ("actor", "operation", "table_name", "row_id", "before", "after") VALUES ($1, $2, $3, $4, $5, ` + rowQuery(t, false) + `)`
+}
+
+// audit is the after hook recording op on the row of table t with the
+// given id, written from model.
+func audit(ctx context.Context, exec boil.ContextExecutor, t modelTable, op AuditOperation, id int, model interface{}) error {
+	old, _ := keptByBeforeHooks(ctx, model, beforeKey{}).(null.JSON)
+
+	actor := ActorFrom(ctx)
+	query := auditQuery(t)
+	args := []interface{}{null.NewString(actor, actor != ""), op, t.name, id, old}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, args)
+	}
+
+	if _, err := exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "dbmodels: unable to record %s of %s %d in audit log", op, t.name, id)
+	}
+	return nil
+}
+
+// AuditTrail returns a query selecting audit log entries, newest first
+// unless mods order them otherwise, e.g. the changes made to an article:
+//
+//	AuditTrail(AuditLogOf[Article](id)).All(ctx, exec)
+func AuditTrail(mods ...qm.QueryMod) auditLogQuery {
+	queryMods := append([]qm.QueryMod{}, mods...)
+	queryMods = append(queryMods, qm.OrderBy(`"audit_log"."id" DESC`))
+
+	return AuditLogs(queryMods...)
+}
+
+// AuditLogOf returns a query mod selecting the audit log entries about the
+// row of T with the given id.
+func AuditLogOf[T Model](id int) qm.QueryMod {
+	return qm.Expr(
+		AuditLogWhere.TableName.EQ(TableOf[T]()),
+		AuditLogWhere.RowID.EQ(id),
+	)
+}
+
+// ChangedColumns returns the columns whose values differ between before
+// and after the change, in alphabetical order. Every column changed when
+// a row was inserted or deleted for good.
+func (o *AuditLog) ChangedColumns() ([]string, error) {
+	var before, after map[string]json.RawMessage
+	if err := o.Before.Unmarshal(&before); err != nil {
+		return nil, errors.Wrapf(err, "dbmodels: invalid before of audit log entry %d", o.ID)
+	}
+	if err := o.After.Unmarshal(&after); err != nil {
+		return nil, errors.Wrapf(err, "dbmodels: invalid after of audit log entry %d", o.ID)
+	}
+
+	var columns []string
+	for column, value := range after {
+		if old, ok := before[column]; !ok || !bytes.Equal(old, value) {
+			columns = append(columns, column)
+		}
+	}
+	for column := range before {
+		if _, ok := after[column]; !ok {
+			columns = append(columns, column)
+		}
+	}
+	sort.Strings(columns)
+
+	return columns, nil
+}
@@ -0,0 +1,83 @@
+package dbmodels
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const beforeQuery = `SELECT \(SELECT to_jsonb\("row"\) FROM \(SELECT .* FROM "article" WHERE "id" = \$1 FOR UPDATE\) AS "row"\)`
+
+func TestAuditBefore(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// The first update finds the article changed by someone else and
+	// records nothing, the second records the article as the before hook
+	// of its own write read it, not the one the failed write read.
+	old := `{"id": 1, "title": "Old", "version": 1}`
+	current := `{"id": 1, "title": "Newer", "version": 2}`
+	mock.ExpectQuery(beforeQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow(old))
+	mock.ExpectExec(`UPDATE "article"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(beforeQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow(current))
+	mock.ExpectExec(`UPDATE "article"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "audit_log" \("actor", "operation", "table_name", "row_id", "before", "after"\) VALUES \(\$1, \$2, \$3, \$4, \$5, \(SELECT to_jsonb`).
+		WithArgs(null.StringFrom("jane"), AuditOperationUpdate, "article", 1, null.JSONFrom([]byte(current))).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "article_revision"`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	ctx := WithActor(context.Background(), "jane")
+	article := &Article{ID: 1, Title: "Newest", Version: 1}
+	var stale *StaleObjectError
+	if _, err := article.Update(ctx, conn, boil.Whitelist(ArticleColumns.Title)); !errors.As(err, &stale) {
+		t.Fatalf("Update() = %v, want a stale object error", err)
+	}
+	article.Version = 2
+	if _, err := article.Update(ctx, conn, boil.Whitelist(ArticleColumns.Title)); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAuditBeforeDeleteAll(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// The before hooks of all articles run before the delete, each entry
+	// records the row read for its own article.
+	first := `{"id": 1, "version": 1}`
+	second := `{"id": 2, "version": 4}`
+	mock.ExpectQuery(beforeQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow(first))
+	mock.ExpectQuery(beforeQuery).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow(second))
+	mock.ExpectExec(`UPDATE "article" SET "deleted_at"`).WillReturnResult(sqlmock.NewResult(0, 2))
+	for _, entry := range []struct {
+		id     int
+		before string
+	}{{1, first}, {2, second}} {
+		mock.ExpectExec(`INSERT INTO "audit_log"`).
+			WithArgs(null.String{}, AuditOperationDelete, "article", entry.id, null.JSONFrom([]byte(entry.before))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	articles := ArticleSlice{{ID: 1, Version: 1}, {ID: 2, Version: 4}}
+	if _, err := articles.DeleteAll(WithActor(context.Background(), ""), conn, false); err != nil {
+		t.Fatalf("DeleteAll() = %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
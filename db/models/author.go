@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,56 +24,73 @@ import (
 
 // Author is an object representing the database table.
 type Author struct {
-	ID    int    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Email string `boil:"email" json:"email" toml:"email" yaml:"email"`
-	Name  string `boil:"name" json:"name" toml:"name" yaml:"name"`
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Email     string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *authorR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L authorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AuthorColumns = struct {
-	ID    string
-	Email string
-	Name  string
+	ID        string
+	Email     string
+	Name      string
+	DeletedAt string
+	UpdatedAt string
 }{
-	ID:    "id",
-	Email: "email",
-	Name:  "name",
+	ID:        "id",
+	Email:     "email",
+	Name:      "name",
+	DeletedAt: "deleted_at",
+	UpdatedAt: "updated_at",
 }
 
 var AuthorTableColumns = struct {
-	ID    string
-	Email string
-	Name  string
+	ID        string
+	Email     string
+	Name      string
+	DeletedAt string
+	UpdatedAt string
 }{
-	ID:    "author.id",
-	Email: "author.email",
-	Name:  "author.name",
+	ID:        "author.id",
+	Email:     "author.email",
+	Name:      "author.name",
+	DeletedAt: "author.deleted_at",
+	UpdatedAt: "author.updated_at",
 }
 
 // Generated where
 
 var AuthorWhere = struct {
-	ID    whereHelperint
-	Email whereHelperstring
-	Name  whereHelperstring
+	ID        whereHelperint
+	Email     whereHelperstring
+	Name      whereHelperstring
+	DeletedAt whereHelpernull_Time
+	UpdatedAt whereHelpertime_Time
 }{
-	ID:    whereHelperint{field: "\"author\".\"id\""},
-	Email: whereHelperstring{field: "\"author\".\"email\""},
-	Name:  whereHelperstring{field: "\"author\".\"name\""},
+	ID:        whereHelperint{field: "\"author\".\"id\""},
+	Email:     whereHelperstring{field: "\"author\".\"email\""},
+	Name:      whereHelperstring{field: "\"author\".\"name\""},
+	DeletedAt: whereHelpernull_Time{field: "\"author\".\"deleted_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"author\".\"updated_at\""},
 }
 
 // AuthorRels is where relationship names are stored.
 var AuthorRels = struct {
-	Articles string
+	Articles       string
+	ArticleAuthors string
 }{
-	Articles: "Articles",
+	Articles:       "Articles",
+	ArticleAuthors: "ArticleAuthors",
 }
 
 // authorR is where relationships are stored.
 type authorR struct {
-	Articles ArticleSlice `boil:"Articles" json:"Articles" toml:"Articles" yaml:"Articles"`
+	Articles       ArticleSlice       `boil:"Articles" json:"Articles" toml:"Articles" yaml:"Articles"`
+	ArticleAuthors ArticleAuthorSlice `boil:"ArticleAuthors" json:"ArticleAuthors" toml:"ArticleAuthors" yaml:"ArticleAuthors"`
 }
 
 // NewStruct creates a new relationship struct
@@ -87,13 +105,20 @@ func (r *authorR) GetArticles() ArticleSlice {
 	return r.Articles
 }
 
+func (r *authorR) GetArticleAuthors() ArticleAuthorSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArticleAuthors
+}
+
 // authorL is where Load methods for each relationship are stored.
 type authorL struct{}
 
 var (
-	authorAllColumns            = []string{"id", "email", "name"}
+	authorAllColumns            = []string{"id", "email", "name", "deleted_at", "updated_at"}
 	authorColumnsWithoutDefault = []string{"email", "name"}
-	authorColumnsWithDefault    = []string{"id"}
+	authorColumnsWithDefault    = []string{"id", "deleted_at", "updated_at"}
 	authorPrimaryKeyColumns     = []string{"id"}
 	authorGeneratedColumns      = []string{}
 )
@@ -410,6 +435,20 @@ func (o *Author) Articles(mods ...qm.QueryMod) articleQuery {
 	return Articles(queryMods...)
 }
 
+// ArticleAuthors retrieves all the article_author's ArticleAuthors with an executor.
+func (o *Author) ArticleAuthors(mods ...qm.QueryMod) articleAuthorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"article_author\".\"author_id\"=?", o.ID),
+	)
+
+	return ArticleAuthors(queryMods...)
+}
+
 // LoadArticles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (authorL) LoadArticles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuthor interface{}, mods queries.Applicator) error {
@@ -468,6 +507,7 @@ func (authorL) LoadArticles(ctx context.Context, e boil.ContextExecutor, singula
 	query := NewQuery(
 		qm.From(`article`),
 		qm.WhereIn(`article.author_id in ?`, args...),
+		qmhelper.WhereIsNull(`article.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -524,6 +564,120 @@ func (authorL) LoadArticles(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
+// LoadArticleAuthors allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (authorL) LoadArticleAuthors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAuthor interface{}, mods queries.Applicator) error {
+	var slice []*Author
+	var object *Author
+
+	if singular {
+		var ok bool
+		object, ok = maybeAuthor.(*Author)
+		if !ok {
+			object = new(Author)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAuthor))
+			}
+		}
+	} else {
+		s, ok := maybeAuthor.(*[]*Author)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAuthor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAuthor))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &authorR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &authorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`article_author`),
+		qm.WhereIn(`article_author.author_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load article_author")
+	}
+
+	var resultSlice []*ArticleAuthor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice article_author")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on article_author")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for article_author")
+	}
+
+	if len(articleAuthorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArticleAuthors = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &articleAuthorR{}
+			}
+			foreign.R.Author = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AuthorID {
+				local.R.ArticleAuthors = append(local.R.ArticleAuthors, foreign)
+				if foreign.R == nil {
+					foreign.R = &articleAuthorR{}
+				}
+				foreign.R.Author = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // AddArticlesG adds the given related objects to the existing relationships
 // of the author, optionally inserting them as new records.
 // Appends related to o.R.Articles.
@@ -586,9 +740,71 @@ func (o *Author) AddArticles(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
+// AddArticleAuthorsG adds the given related objects to the existing relationships
+// of the author, optionally inserting them as new records.
+// Appends related to o.R.ArticleAuthors.
+// Sets related.R.Author appropriately.
+// Uses the global database handle.
+func (o *Author) AddArticleAuthorsG(ctx context.Context, insert bool, related ...*ArticleAuthor) error {
+	return o.AddArticleAuthors(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddArticleAuthors adds the given related objects to the existing relationships
+// of the author, optionally inserting them as new records.
+// Appends related to o.R.ArticleAuthors.
+// Sets related.R.Author appropriately.
+func (o *Author) AddArticleAuthors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ArticleAuthor) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AuthorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"article_author\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"author_id"}),
+				strmangle.WhereClause("\"", "\"", 2, articleAuthorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ArticleID, rel.AuthorID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AuthorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &authorR{
+			ArticleAuthors: related,
+		}
+	} else {
+		o.R.ArticleAuthors = append(o.R.ArticleAuthors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &articleAuthorR{
+				Author: o,
+			}
+		} else {
+			rel.R.Author = o
+		}
+	}
+	return nil
+}
+
 // Authors retrieves all the records using an executor.
 func Authors(mods ...qm.QueryMod) authorQuery {
-	mods = append(mods, qm.From("\"author\""))
+	mods = append(mods, qm.From("\"author\""), qmhelper.WhereIsNull("\"author\".\"deleted_at\""))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"\"author\".*"})
@@ -612,7 +828,7 @@ func FindAuthor(ctx context.Context, exec boil.ContextExecutor, iD int, selectCo
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"author\" where \"id\"=$1", sel,
+		"select %s from \"author\" where \"id\"=$1 and \"deleted_at\" is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -645,6 +861,13 @@ func (o *Author) Insert(ctx context.Context, exec boil.ContextExecutor, columns
 	}
 
 	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
 
 	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
 		return err
@@ -716,6 +939,21 @@ func (o *Author) Insert(ctx context.Context, exec boil.ContextExecutor, columns
 	return o.doAfterInsertHooks(ctx, exec)
 }
 
+// authorTouchUpdatedAt returns cols with updated_at set to the current time, cols is not modified.
+// cols is returned as it is if it sets updated_at already or timestamps are skipped.
+func authorTouchUpdatedAt(ctx context.Context, cols M) M {
+	if _, ok := cols["updated_at"]; ok || boil.TimestampsAreSkipped(ctx) {
+		return cols
+	}
+
+	touched := make(M, len(cols)+1)
+	for name, value := range cols {
+		touched[name] = value
+	}
+	touched["updated_at"] = time.Now().In(boil.GetLocation())
+	return touched
+}
+
 // UpdateG a single Author record using the global executor.
 // See Update for more documentation.
 func (o *Author) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
@@ -725,11 +963,24 @@ func (o *Author) UpdateG(ctx context.Context, columns boil.Columns) (int64, erro
 // Update uses an executor to update the Author.
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+// updated_at is written even if columns is a whitelist without it, unless timestamps are skipped.
 func (o *Author) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
 	var err error
+	ctx = withWrite(ctx)
 	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
 		return 0, err
 	}
+
+	if !boil.TimestampsAreSkipped(ctx) && columns.IsWhitelist() && !strmangle.SetInclude("updated_at", columns.Cols) {
+		columns = boil.Whitelist(strmangle.SetMerge(columns.Cols, []string{"updated_at"})...)
+	}
+
 	key := makeCacheKey(columns, nil)
 	authorUpdateCacheMut.RLock()
 	cache, cached := authorUpdateCache[key]
@@ -791,7 +1042,9 @@ func (q authorQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
 }
 
 // UpdateAll updates all rows with the specified column values.
+// updated_at is set to the current time unless cols sets it or timestamps are skipped.
 func (q authorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	cols = authorTouchUpdatedAt(ctx, cols)
 	queries.SetUpdate(q.Query, cols)
 
 	result, err := q.Query.ExecContext(ctx, exec)
@@ -813,6 +1066,7 @@ func (o AuthorSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
 }
 
 // UpdateAll updates all rows with the specified column values, using an executor.
+// updated_at is set to the current time unless cols sets it or timestamps are skipped.
 func (o AuthorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
 	ln := int64(len(o))
 	if ln == 0 {
@@ -823,6 +1077,8 @@ func (o AuthorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, c
 		return 0, errors.New("dbmodels: update all requires at least one column argument")
 	}
 
+	cols = authorTouchUpdatedAt(ctx, cols)
+
 	colNames := make([]string, len(cols))
 	args := make([]interface{}, len(cols))
 
@@ -867,15 +1123,26 @@ func (o *Author) UpsertG(ctx context.Context, updateOnConflict bool, conflictCol
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+// On conflict, updated_at is updated even if updateColumns is a whitelist without it, unless timestamps are skipped.
 func (o *Author) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
 	if o == nil {
 		return errors.New("dbmodels: no author provided for upsert")
 	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
 
+	ctx = withWrite(ctx)
 	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
 		return err
 	}
 
+	if !boil.TimestampsAreSkipped(ctx) && updateColumns.IsWhitelist() && !strmangle.SetInclude("updated_at", updateColumns.Cols) {
+		updateColumns = boil.Whitelist(strmangle.SetMerge(updateColumns.Cols, []string{"updated_at"})...)
+	}
+
 	nzDefaults := queries.NonZeroDefaultSet(authorColumnsWithDefault, o)
 
 	// Build cache key in-line uglily - mysql vs psql problems
@@ -983,23 +1250,48 @@ func (o *Author) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOn
 
 // DeleteG deletes a single Author record.
 // DeleteG will match against the primary key column to find the record to delete.
-func (o *Author) DeleteG(ctx context.Context) (int64, error) {
-	return o.Delete(ctx, boil.GetContextDB())
+func (o *Author) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // Delete deletes a single Author record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Author) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+// A soft delete also sets updated_at, unless timestamps are skipped.
+func (o *Author) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("dbmodels: no Author provided for delete")
 	}
 
+	ctx = withWrite(ctx)
 	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), authorPrimaryKeyMapping)
-	sql := "DELETE FROM \"author\" WHERE \"id\"=$1"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), authorPrimaryKeyMapping)
+		sql = "DELETE FROM \"author\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		if !boil.TimestampsAreSkipped(ctx) {
+			o.UpdatedAt = currTime
+			wl = append(wl, "updated_at")
+		}
+		sql = fmt.Sprintf("UPDATE \"author\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, authorPrimaryKeyColumns),
+		)
+		valueMapping, err := queries.BindMapping(authorType, authorMapping, append(wl, authorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1023,17 +1315,22 @@ func (o *Author) Delete(ctx context.Context, exec boil.ContextExecutor) (int64,
 	return rowsAff, nil
 }
 
-func (q authorQuery) DeleteAllG(ctx context.Context) (int64, error) {
-	return q.DeleteAll(ctx, boil.GetContextDB())
+func (q authorQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // DeleteAll deletes all matching rows.
-func (q authorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q authorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("dbmodels: no authorQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, authorTouchUpdatedAt(ctx, M{"deleted_at": currTime}))
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1049,16 +1346,18 @@ func (q authorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (
 }
 
 // DeleteAllG deletes all rows in the slice.
-func (o AuthorSlice) DeleteAllG(ctx context.Context) (int64, error) {
-	return o.DeleteAll(ctx, boil.GetContextDB())
+func (o AuthorSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o AuthorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+// A soft delete also sets updated_at, unless timestamps are skipped.
+func (o AuthorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
 
+	ctx = withWrite(ctx)
 	if len(authorBeforeDeleteHooks) != 0 {
 		for _, obj := range o {
 			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
@@ -1067,15 +1366,41 @@ func (o AuthorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), authorPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), authorPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"author\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, authorPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		wl := []string{"deleted_at"}
+		set := []interface{}{currTime}
+		touch := !boil.TimestampsAreSkipped(ctx)
+		if touch {
+			wl = append(wl, "updated_at")
+			set = append(set, currTime)
+		}
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), authorPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+			if touch {
+				obj.UpdatedAt = currTime
+			}
+		}
+		sql = fmt.Sprintf("UPDATE \"author\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(wl)+1, authorPrimaryKeyColumns, len(o)),
+		)
+		args = append(set, args...)
 	}
 
-	sql := "DELETE FROM \"author\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, authorPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1148,7 +1473,8 @@ func (o *AuthorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor)
 	}
 
 	sql := "SELECT \"author\".* FROM \"author\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, authorPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, authorPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1170,7 +1496,7 @@ func AuthorExistsG(ctx context.Context, iD int) (bool, error) {
 // AuthorExists checks if the Author row exists.
 func AuthorExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"author\" where \"id\"=$1 limit 1)"
+	sql := "select exists(select 1 from \"author\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
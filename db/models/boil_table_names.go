@@ -4,9 +4,19 @@
 package dbmodels
 
 var TableNames = struct {
-	Article string
-	Author  string
+	Article         string
+	ArticleAuthor   string
+	ArticleRevision string
+	ArticleTag      string
+	AuditLog        string
+	Author          string
+	Tag             string
 }{
-	Article: "article",
-	Author:  "author",
+	Article:         "article",
+	ArticleAuthor:   "article_author",
+	ArticleRevision: "article_revision",
+	ArticleTag:      "article_tag",
+	AuditLog:        "audit_log",
+	Author:          "author",
+	Tag:             "tag",
 }
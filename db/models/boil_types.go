@@ -50,3 +50,99 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	strmangle.PutBuffer(buf)
 	return str
 }
+
+type ArticleStatus string
+
+// Enum values for ArticleStatus
+const (
+	ArticleStatusDraft     ArticleStatus = "draft"
+	ArticleStatusInReview  ArticleStatus = "in_review"
+	ArticleStatusScheduled ArticleStatus = "scheduled"
+	ArticleStatusPublished ArticleStatus = "published"
+	ArticleStatusArchived  ArticleStatus = "archived"
+)
+
+func AllArticleStatus() []ArticleStatus {
+	return []ArticleStatus{
+		ArticleStatusDraft,
+		ArticleStatusInReview,
+		ArticleStatusScheduled,
+		ArticleStatusPublished,
+		ArticleStatusArchived,
+	}
+}
+
+func (e ArticleStatus) IsValid() error {
+	switch e {
+	case ArticleStatusDraft, ArticleStatusInReview, ArticleStatusScheduled, ArticleStatusPublished, ArticleStatusArchived:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ArticleStatus) String() string {
+	return string(e)
+}
+
+type ArticleAuthorRole string
+
+// Enum values for ArticleAuthorRole
+const (
+	ArticleAuthorRolePrimary     ArticleAuthorRole = "primary"
+	ArticleAuthorRoleContributor ArticleAuthorRole = "contributor"
+	ArticleAuthorRoleEditor      ArticleAuthorRole = "editor"
+)
+
+func AllArticleAuthorRole() []ArticleAuthorRole {
+	return []ArticleAuthorRole{
+		ArticleAuthorRolePrimary,
+		ArticleAuthorRoleContributor,
+		ArticleAuthorRoleEditor,
+	}
+}
+
+func (e ArticleAuthorRole) IsValid() error {
+	switch e {
+	case ArticleAuthorRolePrimary, ArticleAuthorRoleContributor, ArticleAuthorRoleEditor:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ArticleAuthorRole) String() string {
+	return string(e)
+}
+
+type AuditOperation string
+
+// Enum values for AuditOperation
+const (
+	AuditOperationInsert AuditOperation = "insert"
+	AuditOperationUpdate AuditOperation = "update"
+	AuditOperationUpsert AuditOperation = "upsert"
+	AuditOperationDelete AuditOperation = "delete"
+)
+
+func AllAuditOperation() []AuditOperation {
+	return []AuditOperation{
+		AuditOperationInsert,
+		AuditOperationUpdate,
+		AuditOperationUpsert,
+		AuditOperationDelete,
+	}
+}
+
+func (e AuditOperation) IsValid() error {
+	switch e {
+	case AuditOperationInsert, AuditOperationUpdate, AuditOperationUpsert, AuditOperationDelete:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e AuditOperation) String() string {
+	return string(e)
+}
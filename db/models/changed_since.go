@@ -0,0 +1,48 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// The generated Insert, Update, UpdateAll and Upsert set updated_at to the
+// current time unless timestamps are skipped with boil.SkipTimestamps, see
+// templates/main. Soft deletes only set deleted_at.
+
+// ChangedSince returns a query mod selecting the rows of the table of T
+// inserted, updated or soft deleted after since, deleted rows included, so
+// a sync job can pull only what changed since its last run, e.g.
+//
+//	Articles(ChangedSince[Article](lastRun), qm.OrderBy("updated_at")).All(ctx, exec)
+//
+// updated_at is taken from the clock of the application writing the row,
+// so a job should pass the time it started its previous run, minus the
+// clock skew it tolerates, rather than the latest updated_at it has seen.
+func ChangedSince[T Model](since time.Time) qm.QueryMod {
+	t := modelTableOf[T]()
+	if t.updatedAt == "" {
+		panic(fmt.Sprintf("dbmodels: %s has no updated_at", t.name))
+	}
+	return changedSinceQueryMod{table: t, since: since}
+}
+
+type changedSinceQueryMod struct {
+	table modelTable
+	since time.Time
+}
+
+func (m changedSinceQueryMod) Apply(q *queries.Query) {
+	if m.table.deletedAt == "" {
+		qm.Where(m.table.updatedAt+" > ?", m.since).Apply(q)
+		return
+	}
+
+	qm.WithDeleted().Apply(q)
+	qm.Where(fmt.Sprintf("(%s > ? OR %s > ?)", m.table.updatedAt, m.table.deletedAt), m.since, m.since).Apply(q)
+}
@@ -0,0 +1,131 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Articles are credited to their authors through article_author, see the
+// generated Article.ArticleAuthors and Author.ArticleAuthors. Every article
+// has exactly one primary author, which is also its author_id: a trigger
+// credits author_id whenever it is written, so inserting an article
+// credits its author. Other credits are written with Article.SetAuthors.
+
+// creditOrder orders credits like the byline: the primary author, then
+// the contributors and then the editors, each in the order they were set.
+const creditOrder = `"article_author"."role", "article_author"."position", "article_author"."author_id"`
+
+// Authors returns a query selecting the authors credited on the article in
+// byline order, see Credits for their roles. Soft deleted authors are left
+// out.
+func (o *Article) Authors(mods ...qm.QueryMod) authorQuery {
+	queryMods := []qm.QueryMod{
+		qm.InnerJoin(`"article_author" ON "article_author"."author_id" = "author"."id"`),
+		qm.Where(`"article_author"."article_id" = ?`, o.ID),
+	}
+	queryMods = append(queryMods, mods...)
+	queryMods = append(queryMods, qm.OrderBy(creditOrder))
+
+	return Authors(queryMods...)
+}
+
+// Credits returns a query selecting the credits of the article in byline
+// order with their authors loaded. R.Author is nil for soft deleted
+// authors.
+func (o *Article) Credits(mods ...qm.QueryMod) articleAuthorQuery {
+	queryMods := []qm.QueryMod{qm.Load(ArticleAuthorRels.Author)}
+	queryMods = append(queryMods, mods...)
+	queryMods = append(queryMods, qm.OrderBy(creditOrder))
+
+	return o.ArticleAuthors(queryMods...)
+}
+
+// CreditedArticles returns a query selecting the articles the author is
+// credited on in any role, unlike Articles, which only selects those the
+// author is the primary author of.
+func (o *Author) CreditedArticles(mods ...qm.QueryMod) articleQuery {
+	queryMods := []qm.QueryMod{ArticleHasAuthor(o.ID)}
+	queryMods = append(queryMods, mods...)
+
+	return Articles(queryMods...)
+}
+
+// ArticleHasAuthor returns a query mod selecting the articles the author
+// with the given id is credited on in one of roles, or in any role without
+// roles.
+func ArticleHasAuthor(authorID int, roles ...ArticleAuthorRole) qm.QueryMod {
+	if len(roles) == 0 {
+		return qm.Where(`EXISTS (SELECT 1 FROM "article_author" WHERE "article_author"."article_id" = "article"."id" AND "article_author"."author_id" = ?)`, authorID)
+	}
+
+	args := []interface{}{authorID}
+	for _, role := range roles {
+		args = append(args, role)
+	}
+	return qm.WhereIn(`EXISTS (SELECT 1 FROM "article_author" WHERE "article_author"."article_id" = "article"."id" AND "article_author"."author_id" = ? AND "article_author"."role" IN ?)`, args...)
+}
+
+// SetAuthorsG replaces the credits of the article using the global
+// executor. See SetAuthors for more documentation.
+func (o *Article) SetAuthorsG(ctx context.Context, credits ...*ArticleAuthor) error {
+	return o.SetAuthors(ctx, boil.GetContextDB(), credits...)
+}
+
+// SetAuthors replaces the credits of the article with credits, which name
+// an author and a role each. Exactly one of them must be primary; if it
+// isn't the article's author_id yet, the article is updated like Update
+// does, so it fails with ErrStaleObject if the article was changed
+// since it was loaded. Positions are assigned in the order of credits.
+// Use a transaction to replace the credits atomically.
+func (o *Article) SetAuthors(ctx context.Context, exec boil.ContextExecutor, credits ...*ArticleAuthor) error {
+	primary := -1
+	for _, credit := range credits {
+		if err := credit.Role.IsValid(); err != nil {
+			return errors.Wrapf(err, "dbmodels: invalid role %q of author %d", credit.Role, credit.AuthorID)
+		}
+		if credit.Role != ArticleAuthorRolePrimary {
+			continue
+		}
+		if primary != -1 {
+			return errors.Errorf("dbmodels: article %d can have only one primary author, got %d and %d", o.ID, primary, credit.AuthorID)
+		}
+		primary = credit.AuthorID
+	}
+	if primary == -1 {
+		return errors.Errorf("dbmodels: article %d needs a primary author", o.ID)
+	}
+
+	if o.AuthorID != primary {
+		authorID := o.AuthorID
+		o.AuthorID = primary
+		if _, err := o.Update(ctx, exec, boil.Whitelist(ArticleColumns.AuthorID)); err != nil {
+			o.AuthorID = authorID
+			return err
+		}
+	}
+
+	if _, err := ArticleAuthors(ArticleAuthorWhere.ArticleID.EQ(o.ID)).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	for i, credit := range credits {
+		credit.ArticleID = o.ID
+		credit.Position = i
+		if err := credit.Insert(ctx, exec, boil.Infer()); err != nil {
+			return err
+		}
+	}
+
+	if o.R == nil {
+		o.R = &articleR{}
+	}
+	o.R.ArticleAuthors = credits
+
+	return nil
+}
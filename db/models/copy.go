@@ -0,0 +1,373 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// ArticleSource returns the next article to copy, or io.EOF when there are
+// no more.
+type ArticleSource func() (*Article, error)
+
+// AuthorSource returns the next author to copy, or io.EOF when there are no
+// more.
+type AuthorSource func() (*Author, error)
+
+type copyOptions struct {
+	columns       boil.Columns
+	skipHooks     bool
+	progress      func(rows int64)
+	progressEvery int64
+}
+
+// CopyOption configures CopyArticles and CopyAuthors.
+type CopyOption func(*copyOptions)
+
+// CopyColumns chooses the columns to copy. Columns with a default are
+// treated like in Insert, except that nullable columns are always copied:
+// NULL is their default anyway and this way rows don't have to agree on
+// which of them they set. By default every column without a default and
+// every nullable column is copied, which leaves out generated ids.
+func CopyColumns(columns boil.Columns) CopyOption {
+	return func(o *copyOptions) { o.columns = columns }
+}
+
+// CopySkipHooks copies without running the insert hooks, which saves the
+// time spent in them on large imports. Hooks are also skipped if ctx was
+// marked with boil.SkipHooks.
+func CopySkipHooks() CopyOption {
+	return func(o *copyOptions) { o.skipHooks = true }
+}
+
+// CopyProgress calls fn with the number of rows sent so far each time
+// another batch of every rows was sent, and once more at the end.
+func CopyProgress(every int64, fn func(rows int64)) CopyOption {
+	return func(o *copyOptions) {
+		o.progressEvery = every
+		o.progress = fn
+	}
+}
+
+// CopyArticles streams the articles returned by src into the article table
+// with COPY, which is considerably faster than INSERT for large imports,
+// and returns how many were copied.
+//
+// COPY doesn't return anything, so defaults are not filled in, except for
+// the ids the insert hooks need: unless hooks are skipped or the ids are
+// copied, every article is given an id from the sequence of the id column
+// before it is copied. created_at is set if zero, like Insert does. An
+// article that sets a column that isn't copied, such as an id, fails the
+// copy instead of being silently truncated.
+//
+// Unless hooks are skipped, all articles are read into memory first, the
+// before insert hooks run before the COPY starts and the after insert
+// hooks once all rows are sent. Otherwise the articles are streamed.
+//
+// exec must be a transaction or a *sql.DB, in which case the copy runs in
+// a transaction of its own. Nothing is written if the copy fails.
+func CopyArticles(ctx context.Context, exec boil.ContextExecutor, src ArticleSource, opts ...CopyOption) (int64, error) {
+	next := func() (interface{}, error) {
+		article, err := src()
+		if err != nil {
+			return nil, err
+		}
+		if article == nil {
+			return nil, errors.New("dbmodels: no article provided for insertion")
+		}
+
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+
+			if queries.MustTime(article.CreatedAt).IsZero() {
+				queries.SetScanner(&article.CreatedAt, currTime)
+			}
+		}
+
+		return article, nil
+	}
+
+	hooks := copyHooks{
+		before: func(ctx context.Context, exec boil.ContextExecutor, row interface{}) error {
+			return row.(*Article).doBeforeInsertHooks(ctx, exec)
+		},
+		after: func(ctx context.Context, exec boil.ContextExecutor, row interface{}) error {
+			return row.(*Article).doAfterInsertHooks(ctx, exec)
+		},
+		setID: func(row interface{}, id int) { row.(*Article).ID = id },
+	}
+
+	return copyIn(ctx, exec, articleModelTable, next, hooks, opts)
+}
+
+// CopyAll copies the articles of o with CopyArticles.
+func (o ArticleSlice) CopyAll(ctx context.Context, exec boil.ContextExecutor, opts ...CopyOption) (int64, error) {
+	i := 0
+	return CopyArticles(ctx, exec, func() (*Article, error) {
+		if i == len(o) {
+			return nil, io.EOF
+		}
+		i++
+		return o[i-1], nil
+	}, opts...)
+}
+
+// CopyAuthors streams the authors returned by src into the author table
+// with COPY and returns how many were copied. See CopyArticles for the
+// details.
+func CopyAuthors(ctx context.Context, exec boil.ContextExecutor, src AuthorSource, opts ...CopyOption) (int64, error) {
+	next := func() (interface{}, error) {
+		author, err := src()
+		if err != nil {
+			return nil, err
+		}
+		if author == nil {
+			return nil, errors.New("dbmodels: no author provided for insertion")
+		}
+		return author, nil
+	}
+
+	hooks := copyHooks{
+		before: func(ctx context.Context, exec boil.ContextExecutor, row interface{}) error {
+			return row.(*Author).doBeforeInsertHooks(ctx, exec)
+		},
+		after: func(ctx context.Context, exec boil.ContextExecutor, row interface{}) error {
+			return row.(*Author).doAfterInsertHooks(ctx, exec)
+		},
+		setID: func(row interface{}, id int) { row.(*Author).ID = id },
+	}
+
+	return copyIn(ctx, exec, authorModelTable, next, hooks, opts)
+}
+
+// CopyAll copies the authors of o with CopyAuthors.
+func (o AuthorSlice) CopyAll(ctx context.Context, exec boil.ContextExecutor, opts ...CopyOption) (int64, error) {
+	i := 0
+	return CopyAuthors(ctx, exec, func() (*Author, error) {
+		if i == len(o) {
+			return nil, io.EOF
+		}
+		i++
+		return o[i-1], nil
+	}, opts...)
+}
+
+type copyHooks struct {
+	before func(ctx context.Context, exec boil.ContextExecutor, row interface{}) error
+	after  func(ctx context.Context, exec boil.ContextExecutor, row interface{}) error
+	// setID sets the id of a row that is given one for the hooks.
+	setID func(row interface{}, id int)
+}
+
+type preparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+func copyIn(ctx context.Context, exec boil.ContextExecutor, t modelTable, next func() (interface{}, error), hooks copyHooks, opts []CopyOption) (n int64, err error) {
+	o := copyOptions{columns: boil.Infer()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.skipHooks {
+		ctx = boil.SkipHooks(ctx)
+	}
+
+	// lib/pq only supports COPY inside a transaction.
+	if beginner, ok := exec.(boil.ContextBeginner); ok {
+		var tx *sql.Tx
+		tx, err = beginner.BeginTx(ctx, nil)
+		if err != nil {
+			return 0, errors.Wrap(err, "dbmodels: unable to begin transaction")
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+				return
+			}
+			if err = tx.Commit(); err != nil {
+				n = 0
+				err = errors.Wrap(err, "dbmodels: unable to commit copy into "+t.name)
+			}
+		}()
+		exec = tx
+	}
+
+	p, ok := exec.(preparer)
+	if !ok {
+		return 0, fmt.Errorf("dbmodels: %T can't prepare statements", exec)
+	}
+
+	columns, _ := o.columns.InsertColumnSet(t.all, t.withDefault, t.withoutDefault, nullableColumns(t.name, t.withDefault))
+	copied := map[string]bool{}
+	for _, column := range columns {
+		copied[column] = true
+	}
+
+	// check fails row number i if it sets a column that isn't copied.
+	check := func(row interface{}, i int64) error {
+		for _, column := range queries.NonZeroDefaultSet(t.withDefault, row) {
+			if !copied[column] {
+				return fmt.Errorf("dbmodels: row %d sets %s.%s, which isn't copied", i, t.name, column)
+			}
+		}
+		return nil
+	}
+
+	var hooked []interface{}
+	streamed := boil.HooksAreSkipped(ctx)
+	if !streamed {
+		// The connection can't run other statements during the COPY, so
+		// the rows are read and their before hooks run first. COPY doesn't
+		// return the ids the hooks need, so the rows are given ids up
+		// front unless they are copied anyway.
+		for {
+			row, err := next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return 0, err
+			}
+			if err := check(row, int64(len(hooked))+1); err != nil {
+				return 0, err
+			}
+			hooked = append(hooked, row)
+		}
+
+		if !copied["id"] {
+			if err := reserveIDs(ctx, exec, t.name, hooked, hooks.setID); err != nil {
+				return 0, err
+			}
+			columns = append(columns, "id")
+		}
+
+		for _, row := range hooked {
+			if err := hooks.before(ctx, exec, row); err != nil {
+				return 0, err
+			}
+		}
+
+		i := 0
+		next = func() (interface{}, error) {
+			if i == len(hooked) {
+				return nil, io.EOF
+			}
+			i++
+			return hooked[i-1], nil
+		}
+	}
+
+	valueMapping, err := queries.BindMapping(t.typ, t.mapping, columns)
+	if err != nil {
+		return 0, err
+	}
+
+	stmt, err := p.PrepareContext(ctx, pq.CopyIn(t.name, columns...))
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to start copy into "+t.name)
+	}
+	defer stmt.Close()
+
+	for {
+		row, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if streamed {
+			if err := check(row, n+1); err != nil {
+				return 0, err
+			}
+		}
+
+		vals := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(row)), valueMapping)
+		if _, err := stmt.ExecContext(ctx, vals...); err != nil {
+			return 0, errors.Wrap(err, "dbmodels: unable to copy into "+t.name)
+		}
+		n++
+
+		if o.progress != nil && o.progressEvery > 0 && n%o.progressEvery == 0 {
+			o.progress(n)
+		}
+	}
+
+	// Executing without arguments flushes the buffered rows and ends the
+	// COPY, errors in the data are only reported here.
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to copy into "+t.name)
+	}
+	if o.progress != nil && (o.progressEvery <= 0 || n%o.progressEvery != 0) {
+		o.progress(n)
+	}
+
+	for _, row := range hooked {
+		if err := hooks.after(ctx, exec, row); err != nil {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+// reserveIDs sets the ids of rows to values taken from the sequence of the
+// id column of table.
+func reserveIDs(ctx context.Context, exec boil.ContextExecutor, table string, rows []interface{}, setID func(row interface{}, id int)) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	query := `SELECT nextval(pg_get_serial_sequence($1, 'id')) FROM generate_series(1, $2)`
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, table, len(rows))
+	}
+
+	ids, err := exec.QueryContext(ctx, query, table, len(rows))
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reserve ids of "+table)
+	}
+	defer ids.Close()
+
+	i := 0
+	for ; ids.Next(); i++ {
+		var id int
+		if err := ids.Scan(&id); err != nil {
+			return errors.Wrap(err, "dbmodels: unable to reserve ids of "+table)
+		}
+		setID(rows[i], id)
+	}
+	if err := ids.Err(); err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reserve ids of "+table)
+	}
+	if i != len(rows) {
+		return fmt.Errorf("dbmodels: reserved %d ids of %s for %d rows", i, table, len(rows))
+	}
+	return nil
+}
+
+// nullableColumns returns the nullable columns of table among columns.
+func nullableColumns(table string, columns []string) []string {
+	t, _ := LookupTable(table)
+
+	var nullable []string
+	for _, c := range t.Columns {
+		if c.Nullable && contains(columns, c.Name) {
+			nullable = append(nullable, c.Name)
+		}
+	}
+	return nullable
+}
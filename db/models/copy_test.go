@@ -0,0 +1,72 @@
+package dbmodels
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestCopyArticlesFillsIDsForHooks(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT nextval\(pg_get_serial_sequence\(\$1, 'id'\)\) FROM generate_series\(1, \$2\)`).
+		WithArgs("article", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"nextval"}).AddRow(7).AddRow(8))
+	copyIn := mock.ExpectPrepare(`COPY "article" \(.*"id"\) FROM STDIN`)
+	copyIn.ExpectExec().WithArgs("First", null.String{}, sqlmock.AnyArg(), 1, null.Time{}, null.Time{}, 7).WillReturnResult(sqlmock.NewResult(0, 1))
+	copyIn.ExpectExec().WithArgs("Second", null.String{}, sqlmock.AnyArg(), 1, null.Time{}, null.Time{}, 8).WillReturnResult(sqlmock.NewResult(0, 1))
+	copyIn.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 2))
+	for _, id := range []int{7, 8} {
+		mock.ExpectExec(`INSERT INTO "audit_log"`).WithArgs(sqlmock.AnyArg(), AuditOperationInsert, "article", id, null.JSON{}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO "article_revision"`).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+
+	articles := ArticleSlice{{Title: "First", AuthorID: 1}, {Title: "Second", AuthorID: 1}}
+	n, err := articles.CopyAll(context.Background(), conn)
+	if err != nil {
+		t.Fatalf("CopyAll() = %v", err)
+	}
+	if n != 2 {
+		t.Errorf("CopyAll() copied %d articles, want 2", n)
+	}
+	if articles[0].ID != 7 || articles[1].ID != 8 {
+		t.Errorf("ids = %d, %d, want 7, 8", articles[0].ID, articles[1].ID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCopyArticlesSkipHooks(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	mock.ExpectBegin()
+	copyIn := mock.ExpectPrepare(`COPY "article" \("title", "body", "created_at", "author_id", "deleted_at", "published_at"\) FROM STDIN`)
+	copyIn.ExpectExec().WillReturnResult(sqlmock.NewResult(0, 1))
+	copyIn.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	articles := ArticleSlice{{Title: "First", AuthorID: 1}}
+	if _, err := articles.CopyAll(context.Background(), conn, CopySkipHooks()); err != nil {
+		t.Fatalf("CopyAll() = %v", err)
+	}
+	if articles[0].ID != 0 {
+		t.Errorf("id = %d, want none without hooks", articles[0].ID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,212 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// cursors numbers the cursors declared by EachCursor, their names must be
+// unique within a transaction.
+var cursors uint64
+
+// Each calls fn for every Article of the query, scanning the rows one at a
+// time instead of loading them all like All does. The after select hooks
+// run before fn. Iteration stops at the first error returned by fn, which
+// is returned as is. Load mods are ignored.
+//
+// The result set stays open while fn runs. On a transaction that means fn
+// can't run queries on the same transaction, use EachCursor for that.
+func (q articleQuery) Each(ctx context.Context, exec boil.ContextExecutor, fn func(*Article) error) error {
+	return each(ctx, exec, q.Query, articleModelTable, func(row reflect.Value) error {
+		article := row.Addr().Interface().(*Article)
+		if err := article.doAfterSelectHooks(ctx, exec); err != nil {
+			return err
+		}
+		return fn(article)
+	})
+}
+
+// EachG calls fn for every Article of the query using the global executor.
+// See Each for more documentation.
+func (q articleQuery) EachG(ctx context.Context, fn func(*Article) error) error {
+	return q.Each(ctx, boil.GetContextDB(), fn)
+}
+
+// EachCursor is like Each, but reads the rows through a server-side cursor
+// in batches of batchSize. Postgres then doesn't have to materialize the
+// result set before sending it and only one batch is held in memory at a
+// time. The result set isn't open while fn runs, so fn may use the same
+// transaction.
+//
+// Cursors only live inside a transaction. If exec is a *sql.DB, a read-only
+// transaction is started for the iteration.
+func (q articleQuery) EachCursor(ctx context.Context, exec boil.ContextExecutor, batchSize int, fn func(*Article) error) error {
+	return eachCursor(ctx, exec, q.Query, articleModelTable, batchSize, func(row reflect.Value) error {
+		article := row.Addr().Interface().(*Article)
+		if err := article.doAfterSelectHooks(ctx, exec); err != nil {
+			return err
+		}
+		return fn(article)
+	})
+}
+
+// Each calls fn for every Author of the query, scanning the rows one at a
+// time. See articleQuery.Each for more documentation.
+func (q authorQuery) Each(ctx context.Context, exec boil.ContextExecutor, fn func(*Author) error) error {
+	return each(ctx, exec, q.Query, authorModelTable, func(row reflect.Value) error {
+		author := row.Addr().Interface().(*Author)
+		if err := author.doAfterSelectHooks(ctx, exec); err != nil {
+			return err
+		}
+		return fn(author)
+	})
+}
+
+// EachG calls fn for every Author of the query using the global executor.
+// See Each for more documentation.
+func (q authorQuery) EachG(ctx context.Context, fn func(*Author) error) error {
+	return q.Each(ctx, boil.GetContextDB(), fn)
+}
+
+// EachCursor calls fn for every Author of the query, reading the rows
+// through a server-side cursor. See articleQuery.EachCursor for more
+// documentation.
+func (q authorQuery) EachCursor(ctx context.Context, exec boil.ContextExecutor, batchSize int, fn func(*Author) error) error {
+	return eachCursor(ctx, exec, q.Query, authorModelTable, batchSize, func(row reflect.Value) error {
+		author := row.Addr().Interface().(*Author)
+		if err := author.doAfterSelectHooks(ctx, exec); err != nil {
+			return err
+		}
+		return fn(author)
+	})
+}
+
+func each(ctx context.Context, exec boil.ContextExecutor, q *queries.Query, t modelTable, fn func(row reflect.Value) error) error {
+	query, args := queries.BuildQuery(q)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, args)
+	}
+
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to query "+t.name)
+	}
+	defer rows.Close()
+
+	return scanEach(rows, t, fn)
+}
+
+func eachCursor(ctx context.Context, exec boil.ContextExecutor, q *queries.Query, t modelTable, batchSize int, fn func(row reflect.Value) error) (err error) {
+	if batchSize <= 0 {
+		return errors.New("dbmodels: cursor batch size must be positive")
+	}
+
+	if beginner, ok := exec.(boil.ContextBeginner); ok {
+		var tx *sql.Tx
+		tx, err = beginner.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+		if err != nil {
+			return errors.Wrap(err, "dbmodels: unable to begin transaction")
+		}
+		defer func() {
+			if err != nil {
+				tx.Rollback()
+				return
+			}
+			err = tx.Commit()
+		}()
+		exec = tx
+	}
+
+	name := fmt.Sprintf("%s_cursor_%d", t.name, atomic.AddUint64(&cursors, 1))
+	query, args := queries.BuildQuery(q)
+	query = fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s", name, strings.TrimSuffix(query, ";"))
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM %s", batchSize, name)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, args)
+	}
+
+	if _, err := exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrap(err, "dbmodels: unable to declare cursor on "+t.name)
+	}
+	defer func() {
+		// A failed statement aborts the transaction, after which CLOSE
+		// fails as well; the cursor goes away with the transaction then.
+		if _, closeErr := exec.ExecContext(ctx, "CLOSE "+name); closeErr != nil && err == nil {
+			err = errors.Wrap(closeErr, "dbmodels: unable to close cursor on "+t.name)
+		}
+	}()
+
+	for {
+		rows, err := exec.QueryContext(ctx, fetch)
+		if err != nil {
+			return errors.Wrap(err, "dbmodels: unable to fetch from cursor on "+t.name)
+		}
+
+		// Scan the whole batch before calling fn, so fn may use exec.
+		var batch []reflect.Value
+		err = scanEach(rows, t, func(row reflect.Value) error {
+			batch = append(batch, row)
+			return nil
+		})
+		rows.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, row := range batch {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < batchSize {
+			return nil
+		}
+	}
+}
+
+// scanEach scans every row of rows into a new model of t and passes it to
+// fn.
+func scanEach(rows *sql.Rows, t modelTable, fn func(row reflect.Value) error) error {
+	columns, err := rows.Columns()
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to read columns of "+t.name)
+	}
+	mapping, err := queries.BindMapping(t.typ, t.mapping, columns)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to bind columns of "+t.name)
+	}
+
+	for rows.Next() {
+		row := reflect.New(t.typ.Elem()).Elem()
+		if err := rows.Scan(queries.PtrsFromMapping(row, mapping)...); err != nil {
+			return errors.Wrap(err, "dbmodels: unable to scan "+t.name)
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "dbmodels: unable to iterate "+t.name)
+	}
+
+	return nil
+}
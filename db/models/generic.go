@@ -0,0 +1,168 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+)
+
+// Model is the set of generated models the generic helpers work with. It
+// has to be extended by hand when a table is added, together with
+// modelTableOf in tables.go.
+type Model interface {
+	Article | Author | Tag | ArticleAuthor | ArticleRevision | AuditLog
+}
+
+// afterSelectHooker is implemented by every generated model.
+type afterSelectHooker interface {
+	doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) error
+}
+
+// Query returns a query selecting the rows of the table of T, like
+// Articles and Authors do. Soft deleted rows are left out unless mods
+// include qm.WithDeleted.
+func Query[T Model](mods ...qm.QueryMod) *queries.Query {
+	t := modelTableOf[T]()
+
+	mods = append(mods, qm.From(fmt.Sprintf("%q", t.name)))
+	if t.deletedAt != "" {
+		mods = append(mods, qmhelper.WhereIsNull(t.deletedAt))
+	}
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{fmt.Sprintf("%q.*", t.name)})
+	}
+
+	return q
+}
+
+// Find returns every T matching mods and runs their after select hooks,
+// like All does.
+func Find[T Model](ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]*T, error) {
+	var o []*T
+	if err := Query[T](mods...).Bind(ctx, exec, &o); err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to "+modelTableOf[T]().name+" slice")
+	}
+
+	for _, obj := range o {
+		if err := any(obj).(afterSelectHooker).doAfterSelectHooks(ctx, exec); err != nil {
+			return o, err
+		}
+	}
+
+	return o, nil
+}
+
+// FindG returns every T matching mods using the global executor.
+func FindG[T Model](ctx context.Context, mods ...qm.QueryMod) ([]*T, error) {
+	return Find[T](ctx, boil.GetContextDB(), mods...)
+}
+
+// First returns the first T matching mods, like One does. It returns
+// sql.ErrNoRows if there is none.
+func First[T Model](ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) (*T, error) {
+	o := new(T)
+
+	q := Query[T](mods...)
+	queries.SetLimit(q, 1)
+
+	if err := q.Bind(ctx, exec, o); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for "+modelTableOf[T]().name)
+	}
+
+	if err := any(o).(afterSelectHooker).doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// FirstG returns the first T matching mods using the global executor.
+func FirstG[T Model](ctx context.Context, mods ...qm.QueryMod) (*T, error) {
+	return First[T](ctx, boil.GetContextDB(), mods...)
+}
+
+// Pair is a row of a join of the tables of A and B.
+type Pair[A, B Model] struct {
+	A *A
+	B *B
+}
+
+// FindPairs returns the rows of the table of A joined with the table of B,
+// bound to both models. mods must join the table of B, e.g. with
+// qm.InnerJoin, and must not select columns: every column of both tables
+// is selected as "table.column" so columns with the same name don't mix
+// up. A and B must be different tables and the after select hooks of both
+// run for every row.
+//
+// Rows of an outer join without a match can't be bound, as the columns of
+// the missing side are NULL.
+//
+// Soft deleted rows of both tables are left out. qm.WithDeleted only
+// includes the deleted rows of A.
+func FindPairs[A, B Model](ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]Pair[A, B], error) {
+	a, b := modelTableOf[A](), modelTableOf[B]()
+	if a.name == b.name {
+		return nil, errors.New("dbmodels: pairs must join different tables, " + a.name + " is joined with itself")
+	}
+
+	// Bind recurses into fields tagged with ",bind", looking for columns
+	// prefixed with the tag name.
+	row := reflect.StructOf([]reflect.StructField{
+		{Name: "A", Type: a.typ.Elem(), Tag: reflect.StructTag(fmt.Sprintf(`boil:"%s,bind"`, a.name))},
+		{Name: "B", Type: b.typ.Elem(), Tag: reflect.StructTag(fmt.Sprintf(`boil:"%s,bind"`, b.name))},
+	})
+
+	var columns []string
+	for _, t := range []modelTable{a, b} {
+		for _, c := range t.all {
+			columns = append(columns, fmt.Sprintf(`%q.%q as "%s.%s"`, t.name, c, t.name, c))
+		}
+	}
+
+	mods = append([]qm.QueryMod{qm.Select(columns...), qm.From(fmt.Sprintf("%q", a.name))}, mods...)
+	// qm.WithDeleted removes the last deleted_at clause, the one of A.
+	for _, t := range []modelTable{b, a} {
+		if t.deletedAt != "" {
+			mods = append(mods, qmhelper.WhereIsNull(t.deletedAt))
+		}
+	}
+
+	q := NewQuery(mods...)
+	rows := reflect.New(reflect.SliceOf(reflect.PtrTo(row)))
+	if err := q.Bind(ctx, exec, rows.Interface()); err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to "+a.name+" and "+b.name+" pairs")
+	}
+
+	rows = rows.Elem()
+	pairs := make([]Pair[A, B], rows.Len())
+	for i := range pairs {
+		r := rows.Index(i).Elem()
+		pairs[i] = Pair[A, B]{
+			A: r.Field(0).Addr().Interface().(*A),
+			B: r.Field(1).Addr().Interface().(*B),
+		}
+
+		if err := any(pairs[i].A).(afterSelectHooker).doAfterSelectHooks(ctx, exec); err != nil {
+			return pairs, err
+		}
+		if err := any(pairs[i].B).(afterSelectHooker).doAfterSelectHooks(ctx, exec); err != nil {
+			return pairs, err
+		}
+	}
+
+	return pairs, nil
+}
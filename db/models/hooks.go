@@ -0,0 +1,41 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import "context"
+
+// writeKey is the context key of the values the before hooks of a write
+// keep for its after hooks, see withWrite.
+type writeKey struct{}
+
+// writeValue identifies a value kept for the after hooks of the write of
+// model.
+type writeValue struct {
+	model interface{}
+	key   interface{}
+}
+
+// withWrite returns a copy of ctx for the hooks of a single write, whose
+// before hooks can keep values for its after hooks with keepForAfterHooks.
+// Update, Upsert and Delete call it before running their before hooks,
+// see the templates in templates/main, so the values of a write are gone
+// with it, whether it fails or not, and never seen by other writes.
+func withWrite(ctx context.Context) context.Context {
+	return context.WithValue(ctx, writeKey{}, map[writeValue]interface{}{})
+}
+
+// keepForAfterHooks keeps value under key for the after hooks of the
+// write of model that ctx was passed to.
+func keepForAfterHooks(ctx context.Context, model, key, value interface{}) {
+	if values, ok := ctx.Value(writeKey{}).(map[writeValue]interface{}); ok {
+		values[writeValue{model, key}] = value
+	}
+}
+
+// keptByBeforeHooks returns the value the before hooks of the write of
+// model kept under key, or nil if they didn't.
+func keptByBeforeHooks(ctx context.Context, model, key interface{}) interface{} {
+	values, _ := ctx.Value(writeKey{}).(map[writeValue]interface{})
+	return values[writeValue{model, key}]
+}
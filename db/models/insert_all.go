@@ -0,0 +1,257 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// maxParams is the most bind parameters Postgres accepts in one statement.
+const maxParams = 65535
+
+// InsertAll inserts all articles using multi-row INSERT statements, as
+// many rows per statement as the parameter limit allows, and fills in the
+// ids and defaults returned by the database.
+//
+// It behaves like calling Insert for every article: created_at and
+// updated_at are set if zero, columns is resolved per row and the before insert hooks of every
+// article run before the first statement, the after insert hooks after the
+// last. Use a transaction to make the inserts atomic.
+//
+// Rows that insert different columns, e.g. because only some of them set
+// created_at, go into separate statements, so ids aren't necessarily
+// assigned in slice order.
+func (o ArticleSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, len(o))
+	for i, article := range o {
+		if article == nil {
+			return errors.New("dbmodels: no article provided for insertion")
+		}
+
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+
+			if queries.MustTime(article.CreatedAt).IsZero() {
+				queries.SetScanner(&article.CreatedAt, currTime)
+			}
+			if article.UpdatedAt.IsZero() {
+				article.UpdatedAt = currTime
+			}
+		}
+
+		if err := article.doBeforeInsertHooks(ctx, exec); err != nil {
+			return err
+		}
+		rows[i] = article
+	}
+
+	if err := insertAll(ctx, exec, articleModelTable, columns, rows); err != nil {
+		return err
+	}
+
+	for _, article := range o {
+		if err := article.doAfterInsertHooks(ctx, exec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InsertAllG inserts all articles using the global executor.
+// See InsertAll for more documentation.
+func (o ArticleSlice) InsertAllG(ctx context.Context, columns boil.Columns) error {
+	return o.InsertAll(ctx, boil.GetContextDB(), columns)
+}
+
+// InsertAll inserts all authors using multi-row INSERT statements, as many
+// rows per statement as the parameter limit allows, and fills in the ids
+// returned by the database.
+//
+// It behaves like calling Insert for every author: updated_at is set if
+// zero, columns is resolved per row and the before insert hooks of every
+// author run before the first statement, the after insert hooks after the
+// last. Use a transaction to make the inserts atomic.
+func (o AuthorSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if len(o) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, len(o))
+	for i, author := range o {
+		if author == nil {
+			return errors.New("dbmodels: no author provided for insertion")
+		}
+
+		if !boil.TimestampsAreSkipped(ctx) && author.UpdatedAt.IsZero() {
+			author.UpdatedAt = time.Now().In(boil.GetLocation())
+		}
+
+		if err := author.doBeforeInsertHooks(ctx, exec); err != nil {
+			return err
+		}
+		rows[i] = author
+	}
+
+	if err := insertAll(ctx, exec, authorModelTable, columns, rows); err != nil {
+		return err
+	}
+
+	for _, author := range o {
+		if err := author.doAfterInsertHooks(ctx, exec); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InsertAllG inserts all authors using the global executor.
+// See InsertAll for more documentation.
+func (o AuthorSlice) InsertAllG(ctx context.Context, columns boil.Columns) error {
+	return o.InsertAll(ctx, boil.GetContextDB(), columns)
+}
+
+// insertBatch is a set of rows inserting and returning the same columns.
+type insertBatch struct {
+	insert  []string
+	returns []string
+	rows    []reflect.Value
+}
+
+// insertAll inserts rows, pointers to models of table t. Rows are grouped
+// by the columns they insert, which differ when some rows set a column
+// with a default and others don't, and every group is inserted in chunks
+// below maxParams.
+func insertAll(ctx context.Context, exec boil.ContextExecutor, t modelTable, columns boil.Columns, rows []interface{}) error {
+	var batches []*insertBatch
+	byKey := map[string]*insertBatch{}
+
+	for _, row := range rows {
+		nzDefaults := queries.NonZeroDefaultSet(t.withDefault, row)
+		key := makeCacheKey(columns, nzDefaults)
+
+		batch, ok := byKey[key]
+		if !ok {
+			batch = &insertBatch{}
+			batch.insert, batch.returns = columns.InsertColumnSet(t.all, t.withDefault, t.withoutDefault, nzDefaults)
+			byKey[key] = batch
+			batches = append(batches, batch)
+		}
+		batch.rows = append(batch.rows, reflect.Indirect(reflect.ValueOf(row)))
+	}
+
+	for _, batch := range batches {
+		valueMapping, err := queries.BindMapping(t.typ, t.mapping, batch.insert)
+		if err != nil {
+			return err
+		}
+		retMapping, err := queries.BindMapping(t.typ, t.mapping, batch.returns)
+		if err != nil {
+			return err
+		}
+
+		// A row without any column to insert needs DEFAULT VALUES, which
+		// only inserts a single row.
+		size := 1
+		if len(batch.insert) > 0 {
+			size = maxParams / len(batch.insert)
+		}
+
+		for start := 0; start < len(batch.rows); start += size {
+			end := start + size
+			if end > len(batch.rows) {
+				end = len(batch.rows)
+			}
+
+			chunk := batch.rows[start:end]
+			if err := insertChunk(ctx, exec, t.name, batch, valueMapping, retMapping, chunk); err != nil {
+				return errors.Wrap(err, "dbmodels: unable to insert into "+t.name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func insertChunk(ctx context.Context, exec boil.ContextExecutor, table string, batch *insertBatch, valueMapping, retMapping []uint64, rows []reflect.Value) error {
+	var query strings.Builder
+	var vals []interface{}
+
+	fmt.Fprintf(&query, "INSERT INTO \"%s\" ", table)
+	if len(batch.insert) == 0 {
+		query.WriteString("DEFAULT VALUES")
+	} else {
+		fmt.Fprintf(&query, "(\"%s\") VALUES ", strings.Join(batch.insert, "\",\""))
+		for i, row := range rows {
+			if i > 0 {
+				query.WriteByte(',')
+			}
+			query.WriteByte('(')
+			for j := range batch.insert {
+				if j > 0 {
+					query.WriteByte(',')
+				}
+				query.WriteString("$" + strconv.Itoa(len(vals)+j+1))
+			}
+			query.WriteByte(')')
+			vals = append(vals, queries.ValuesFromMapping(row, valueMapping)...)
+		}
+	}
+	if len(retMapping) != 0 {
+		fmt.Fprintf(&query, " RETURNING \"%s\"", strings.Join(batch.returns, "\",\""))
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query.String())
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(retMapping) == 0 {
+		_, err := exec.ExecContext(ctx, query.String(), vals...)
+		return err
+	}
+
+	result, err := exec.QueryContext(ctx, query.String(), vals...)
+	if err != nil {
+		return err
+	}
+	defer result.Close()
+
+	// Postgres returns the rows of an INSERT ... VALUES in the order of the
+	// VALUES list, which is what lets the returned columns be matched back
+	// to the models.
+	n := 0
+	for result.Next() {
+		if n == len(rows) {
+			return errors.New("more rows returned than inserted")
+		}
+		if err := result.Scan(queries.PtrsFromMapping(rows[n], retMapping)...); err != nil {
+			return err
+		}
+		n++
+	}
+	if err := result.Err(); err != nil {
+		return err
+	}
+	if n != len(rows) {
+		return fmt.Errorf("%d rows returned for %d inserted", n, len(rows))
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"reflect"
+	"time"
+)
+
+// Timestamps are stored as timestamptz. The models set them in
+// boil.GetLocation, while loaded ones are in whatever offset the session
+// time zone of the connection has; both are the same points in time.
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// InLocation returns v with its timestamps converted to loc, so they are
+// shown in loc when v is encoded. v is a model, a slice of models or
+// anything holding them, such as a pagination.Page or loaded
+// relationships. Models v points to are converted in place. Zero times are
+// left alone.
+func InLocation[T any](v T, loc *time.Location) T {
+	inLocation(reflect.ValueOf(&v).Elem(), loc, map[visit]bool{})
+	return v
+}
+
+// visit is a pointer walked by inLocation.
+type visit struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+// inLocation walks v, seen holds the pointers already walked so models
+// referring to each other through their relationships are walked once.
+func inLocation(v reflect.Value, loc *time.Location, seen map[visit]bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		key := visit{v.Type(), v.Pointer()}
+		if v.IsNil() || seen[key] {
+			return
+		}
+		seen[key] = true
+		inLocation(v.Elem(), loc, seen)
+	case reflect.Interface:
+		if v.IsNil() || !v.CanSet() {
+			return
+		}
+		// The value held by an interface can't be changed, only replaced.
+		e := reflect.New(v.Elem().Type()).Elem()
+		e.Set(v.Elem())
+		inLocation(e, loc, seen)
+		v.Set(e)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			inLocation(v.Index(i), loc, seen)
+		}
+	case reflect.Struct:
+		if v.Type() == timeType {
+			if t := v.Interface().(time.Time); v.CanSet() && !t.IsZero() {
+				v.Set(reflect.ValueOf(t.In(loc)))
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				inLocation(v.Field(i), loc, seen)
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"fmt"
+
+	"github.com/friendsofgo/errors"
+)
+
+// ErrStaleObject is matched by the *StaleObjectError returned when writing
+// a row of a table with a version column, such as article, that was changed
+// or deleted since it was loaded. The code writing versioned rows is
+// generated from the templates in templates/main.
+var ErrStaleObject = errors.New("dbmodels: stale object")
+
+// StaleObjectError is returned by Update, UpdateAll and Upsert of versioned
+// models when rows were changed or deleted since they were loaded. Reload
+// the rows to get their current version.
+type StaleObjectError struct {
+	Table string
+	// Rows is the number of stale rows.
+	Rows int
+}
+
+func (e *StaleObjectError) Error() string {
+	if e.Rows == 1 {
+		return fmt.Sprintf("dbmodels: %s row was changed or deleted since it was loaded", e.Table)
+	}
+	return fmt.Sprintf("dbmodels: %d %s rows were changed or deleted since they were loaded", e.Rows, e.Table)
+}
+
+// Is makes errors.Is match ErrStaleObject.
+func (e *StaleObjectError) Is(target error) bool {
+	return target == ErrStaleObject
+}
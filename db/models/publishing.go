@@ -0,0 +1,239 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Articles are inserted as drafts and only reach readers once they are
+// published. Their status changes along ArticleTransitions, through
+// Article.Transition or the methods named after the transitions, which
+// also keep published_at.
+
+// ArticleTransitions lists the statuses an article can move to from each
+// status:
+//
+//	draft      -> in_review, scheduled, published, archived
+//	in_review  -> draft, scheduled, published, archived
+//	scheduled  -> draft, published, archived
+//	published  -> draft, archived
+//	archived   -> draft
+var ArticleTransitions = map[ArticleStatus][]ArticleStatus{
+	ArticleStatusDraft:     {ArticleStatusInReview, ArticleStatusScheduled, ArticleStatusPublished, ArticleStatusArchived},
+	ArticleStatusInReview:  {ArticleStatusDraft, ArticleStatusScheduled, ArticleStatusPublished, ArticleStatusArchived},
+	ArticleStatusScheduled: {ArticleStatusDraft, ArticleStatusPublished, ArticleStatusArchived},
+	ArticleStatusPublished: {ArticleStatusDraft, ArticleStatusArchived},
+	ArticleStatusArchived:  {ArticleStatusDraft},
+}
+
+// CanTransition reports whether an article can move from status from to
+// status to.
+func CanTransition(from, to ArticleStatus) bool {
+	for _, s := range ArticleTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrIllegalTransition is matched by the *TransitionError returned when an
+// article is moved to a status it can't reach from its current one.
+var ErrIllegalTransition = errors.New("dbmodels: illegal status transition")
+
+// TransitionError is returned when an article can't move from status From
+// to status To, see ArticleTransitions.
+type TransitionError struct {
+	ArticleID int
+	From      ArticleStatus
+	To        ArticleStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("dbmodels: article %d can't go from %s to %s", e.ArticleID, e.From, e.To)
+}
+
+// Is makes errors.Is match ErrIllegalTransition.
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrIllegalTransition
+}
+
+// ChangeStatus moves the article to status to without writing it, see
+// Transition.
+func (o *Article) ChangeStatus(to ArticleStatus, at time.Time) error {
+	if err := to.IsValid(); err != nil {
+		return errors.Wrapf(err, "dbmodels: invalid article status %q", to)
+	}
+	if !CanTransition(o.Status, to) {
+		return &TransitionError{ArticleID: o.ID, From: o.Status, To: to}
+	}
+	if at.IsZero() && (to == ArticleStatusScheduled || to == ArticleStatusPublished) {
+		return errors.Errorf("dbmodels: article %d needs a time to be %s", o.ID, to)
+	}
+
+	switch to {
+	case ArticleStatusScheduled:
+		o.PublishedAt = null.TimeFrom(at)
+	case ArticleStatusPublished:
+		// Articles published by schedule keep the time they were
+		// scheduled at, those published early are published now.
+		if o.Status != ArticleStatusScheduled || o.PublishedAt.Time.After(at) {
+			o.PublishedAt = null.TimeFrom(at)
+		}
+	case ArticleStatusArchived:
+		// Archived articles remember when they were published, if they
+		// were.
+		if o.Status != ArticleStatusPublished {
+			o.PublishedAt = null.Time{}
+		}
+	default:
+		o.PublishedAt = null.Time{}
+	}
+	o.Status = to
+
+	return nil
+}
+
+// TransitionG moves the article to status to using the global executor.
+// See Transition for more documentation.
+func (o *Article) TransitionG(ctx context.Context, to ArticleStatus, at time.Time) error {
+	return o.Transition(ctx, boil.GetContextDB(), to, at)
+}
+
+// Transition moves the article to status to and writes its status and
+// published_at like Update does, so it fails with ErrStaleObject if the
+// article was changed since it was loaded. It fails with a
+// *TransitionError if ArticleTransitions doesn't allow the move.
+//
+// at is the time the article is published at when it is scheduled, and
+// the current time when it is published. published_at is cleared when an
+// article goes back to draft or in_review, or is archived without having
+// been published.
+func (o *Article) Transition(ctx context.Context, exec boil.ContextExecutor, to ArticleStatus, at time.Time) error {
+	status, publishedAt := o.Status, o.PublishedAt
+	if err := o.ChangeStatus(to, at); err != nil {
+		return err
+	}
+
+	_, err := o.Update(ctx, exec, boil.Whitelist(ArticleColumns.Status, ArticleColumns.PublishedAt))
+	if err != nil {
+		o.Status, o.PublishedAt = status, publishedAt
+		return err
+	}
+	return nil
+}
+
+// SubmitG submits the article for review using the global executor.
+func (o *Article) SubmitG(ctx context.Context) error {
+	return o.Submit(ctx, boil.GetContextDB())
+}
+
+// Submit moves a draft to in_review, see Transition.
+func (o *Article) Submit(ctx context.Context, exec boil.ContextExecutor) error {
+	return o.Transition(ctx, exec, ArticleStatusInReview, time.Time{})
+}
+
+// ScheduleG schedules the article using the global executor.
+func (o *Article) ScheduleG(ctx context.Context, at time.Time) error {
+	return o.Schedule(ctx, boil.GetContextDB(), at)
+}
+
+// Schedule moves the article to scheduled, to be published at at, see
+// Transition.
+func (o *Article) Schedule(ctx context.Context, exec boil.ContextExecutor, at time.Time) error {
+	return o.Transition(ctx, exec, ArticleStatusScheduled, at)
+}
+
+// PublishG publishes the article using the global executor.
+func (o *Article) PublishG(ctx context.Context) error {
+	return o.Publish(ctx, boil.GetContextDB())
+}
+
+// Publish moves the article to published as of now, see Transition.
+func (o *Article) Publish(ctx context.Context, exec boil.ContextExecutor) error {
+	return o.Transition(ctx, exec, ArticleStatusPublished, time.Now().In(boil.GetLocation()))
+}
+
+// UnpublishG unpublishes the article using the global executor.
+func (o *Article) UnpublishG(ctx context.Context) error {
+	return o.Unpublish(ctx, boil.GetContextDB())
+}
+
+// Unpublish moves the article back to draft, see Transition.
+func (o *Article) Unpublish(ctx context.Context, exec boil.ContextExecutor) error {
+	return o.Transition(ctx, exec, ArticleStatusDraft, time.Time{})
+}
+
+// ArchiveG archives the article using the global executor.
+func (o *Article) ArchiveG(ctx context.Context) error {
+	return o.Archive(ctx, boil.GetContextDB())
+}
+
+// Archive moves the article to archived, see Transition.
+func (o *Article) Archive(ctx context.Context, exec boil.ContextExecutor) error {
+	return o.Transition(ctx, exec, ArticleStatusArchived, time.Time{})
+}
+
+// ArticleIsPublished returns a query mod selecting published articles,
+// the only ones readers should see.
+func ArticleIsPublished() qm.QueryMod {
+	return ArticleWhere.Status.EQ(ArticleStatusPublished)
+}
+
+// WithUnpublished returns a query mod that makes SearchArticles, when
+// passed in SearchOptions.Mods, and TagCloud include articles that aren't
+// published, e.g. for editors. Without it they only find and count
+// published articles. Other queries ignore it.
+func WithUnpublished() qm.QueryMod {
+	return unpublishedQueryMod{}
+}
+
+type unpublishedQueryMod struct{}
+
+func (unpublishedQueryMod) Apply(*queries.Query) {}
+
+// publishedScope returns the query mods restricting a reader facing query
+// to published articles, none if mods contain WithUnpublished.
+func publishedScope(mods []qm.QueryMod) []qm.QueryMod {
+	for _, mod := range mods {
+		if _, ok := mod.(unpublishedQueryMod); ok {
+			return nil
+		}
+	}
+	return []qm.QueryMod{ArticleIsPublished()}
+}
+
+// PublishedArticles returns a query selecting the published articles,
+// newest first unless mods order them otherwise, e.g. for a feed:
+//
+//	PublishedArticles(qm.Limit(20)).All(ctx, exec)
+func PublishedArticles(mods ...qm.QueryMod) articleQuery {
+	queryMods := []qm.QueryMod{ArticleIsPublished()}
+	queryMods = append(queryMods, mods...)
+	queryMods = append(queryMods, qm.OrderBy(`"article"."published_at" DESC, "article"."id" DESC`))
+
+	return Articles(queryMods...)
+}
+
+// DueArticles returns a query selecting the scheduled articles that are to
+// be published at or before now, earliest first, see publisher.Worker.
+func DueArticles(now time.Time, mods ...qm.QueryMod) articleQuery {
+	queryMods := []qm.QueryMod{
+		ArticleWhere.Status.EQ(ArticleStatusScheduled),
+		ArticleWhere.PublishedAt.LTE(null.TimeFrom(now)),
+	}
+	queryMods = append(queryMods, mods...)
+	queryMods = append(queryMods, qm.OrderBy(`"article"."published_at", "article"."id"`))
+
+	return Articles(queryMods...)
+}
@@ -0,0 +1,91 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Every version of the title and body of an article is kept in
+// article_revision, keyed by the article version that introduced it. The
+// after insert, update and upsert hooks of Article record a revision
+// whenever the title or body differ from the latest one, using the
+// executor of the write, so a transaction covers both. Writes that skip
+// the hooks, such as UpdateAll or a Copy with CopySkipHooks, record no
+// revision; the next hooked write does.
+
+func init() {
+	AddArticleHook(boil.AfterInsertHook, recordRevision)
+	AddArticleHook(boil.AfterUpdateHook, recordRevision)
+	AddArticleHook(boil.AfterUpsertHook, recordRevision)
+}
+
+// recordRevisionQuery copies the title and body of an article as written
+// to article_revision, unless they are those of its latest revision. They
+// are read back rather than taken from the model, which may hold columns a
+// whitelist left unwritten.
+const recordRevisionQuery = `INSERT INTO "article_revision" ("article_id", "version", "title", "body", "created_at")
+SELECT "article"."id", "article"."version", "article"."title", "article"."body", "article"."updated_at" FROM "article"
+WHERE "article"."id" = $1 AND (
+	SELECT ROW("article_revision"."title", "article_revision"."body") FROM "article_revision"
+	WHERE "article_revision"."article_id" = "article"."id"
+	ORDER BY "article_revision"."version" DESC LIMIT 1
+) IS DISTINCT FROM ROW("article"."title", "article"."body")`
+
+// recordRevision is the hook recording the revisions of articles.
+func recordRevision(ctx context.Context, exec boil.ContextExecutor, o *Article) error {
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, recordRevisionQuery)
+		fmt.Fprintln(writer, o.ID)
+	}
+
+	if _, err := exec.ExecContext(ctx, recordRevisionQuery, o.ID); err != nil {
+		return errors.Wrapf(err, "dbmodels: unable to record revision of article %d", o.ID)
+	}
+	return nil
+}
+
+// History returns a query selecting the revisions of the article, newest
+// first.
+func (o *Article) History(mods ...qm.QueryMod) articleRevisionQuery {
+	queryMods := append([]qm.QueryMod{}, mods...)
+	queryMods = append(queryMods, qm.OrderBy(`"article_revision"."version" DESC`))
+
+	return o.ArticleRevisions(queryMods...)
+}
+
+// RevertG reverts the article to a revision using the global executor. See
+// Revert for more documentation.
+func (o *Article) RevertG(ctx context.Context, version int) (*ArticleRevision, error) {
+	return o.Revert(ctx, boil.GetContextDB(), version)
+}
+
+// Revert sets the title and body of the article back to those of its
+// revision at version and writes them like Update does, so it fails with
+// ErrStaleObject if the article was changed since it was loaded. The
+// hooks record the result as a new revision unless it is the latest one
+// already. It returns the revision reverted to, or fails with
+// sql.ErrNoRows if the article has no revision at version.
+func (o *Article) Revert(ctx context.Context, exec boil.ContextExecutor, version int) (*ArticleRevision, error) {
+	revision, err := FindArticleRevision(ctx, exec, o.ID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	title, body := o.Title, o.Body
+	o.Title, o.Body = revision.Title, revision.Body
+
+	_, err = o.Update(ctx, exec, boil.Whitelist(ArticleColumns.Title, ArticleColumns.Body))
+	if err != nil {
+		o.Title, o.Body = title, body
+		return nil, err
+	}
+	return revision, nil
+}
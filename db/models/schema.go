@@ -0,0 +1,122 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Table describes a table as it was when the models were generated, so it
+// can be compared against a live database.
+type Table struct {
+	Name        string
+	Columns     []Column
+	PrimaryKey  []string
+	ForeignKeys []ForeignKey
+}
+
+// Column describes a column of a Table.
+type Column struct {
+	Name string
+	// Type is the Go type of the model field holding the column.
+	Type reflect.Type
+	// Nullable is set for columns held in a null.* type.
+	Nullable bool
+	// HasDefault is set for columns sqlboiler treats as having a default,
+	// which includes every nullable column.
+	HasDefault bool
+}
+
+// ForeignKey describes a single column foreign key of a Table.
+type ForeignKey struct {
+	Name          string
+	Column        string
+	ForeignTable  string
+	ForeignColumn string
+}
+
+// Tables describes every table the models were generated from. It has to
+// be extended by hand when a table or relationship is added.
+var Tables = []Table{
+	newTable(TableNames.Author, Author{}, authorAllColumns, authorColumnsWithDefault, authorPrimaryKeyColumns),
+	newTable(TableNames.Article, Article{}, articleAllColumns, articleColumnsWithDefault, articlePrimaryKeyColumns,
+		ForeignKey{Name: "fk_author_id", Column: ArticleColumns.AuthorID, ForeignTable: TableNames.Author, ForeignColumn: AuthorColumns.ID},
+	),
+	newTable(TableNames.Tag, Tag{}, tagAllColumns, tagColumnsWithDefault, tagPrimaryKeyColumns),
+	newTable(TableNames.ArticleAuthor, ArticleAuthor{}, articleAuthorAllColumns, articleAuthorColumnsWithDefault, articleAuthorPrimaryKeyColumns,
+		ForeignKey{Name: "fk_article_id", Column: ArticleAuthorColumns.ArticleID, ForeignTable: TableNames.Article, ForeignColumn: ArticleColumns.ID},
+		ForeignKey{Name: "fk_author_id", Column: ArticleAuthorColumns.AuthorID, ForeignTable: TableNames.Author, ForeignColumn: AuthorColumns.ID},
+	),
+	newTable(TableNames.ArticleRevision, ArticleRevision{}, articleRevisionAllColumns, articleRevisionColumnsWithDefault, articleRevisionPrimaryKeyColumns,
+		ForeignKey{Name: "fk_article_id", Column: ArticleRevisionColumns.ArticleID, ForeignTable: TableNames.Article, ForeignColumn: ArticleColumns.ID},
+	),
+	newTable(TableNames.AuditLog, AuditLog{}, auditLogAllColumns, auditLogColumnsWithDefault, auditLogPrimaryKeyColumns),
+	// article_tag is a join table, which has no model.
+	{
+		Name: TableNames.ArticleTag,
+		Columns: []Column{
+			{Name: "article_id", Type: reflect.TypeOf(0)},
+			{Name: "tag_id", Type: reflect.TypeOf(0)},
+		},
+		PrimaryKey: []string{"article_id", "tag_id"},
+		ForeignKeys: []ForeignKey{
+			{Name: "fk_article_id", Column: "article_id", ForeignTable: TableNames.Article, ForeignColumn: ArticleColumns.ID},
+			{Name: "fk_tag_id", Column: "tag_id", ForeignTable: TableNames.Tag, ForeignColumn: TagColumns.ID},
+		},
+	},
+}
+
+// LookupTable returns the entry of Tables for the table called name.
+func LookupTable(name string) (Table, bool) {
+	for _, t := range Tables {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return Table{}, false
+}
+
+// Column returns the column called name.
+func (t Table) Column(name string) (Column, bool) {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Column{}, false
+}
+
+func newTable(name string, model interface{}, all, withDefault, primaryKey []string, foreignKeys ...ForeignKey) Table {
+	fields := map[string]reflect.Type{}
+	modelType := reflect.TypeOf(model)
+	for i := 0; i < modelType.NumField(); i++ {
+		field := modelType.Field(i)
+		if tag := field.Tag.Get("boil"); tag != "" && tag != "-" {
+			fields[strings.Split(tag, ",")[0]] = field.Type
+		}
+	}
+
+	table := Table{Name: name, PrimaryKey: primaryKey, ForeignKeys: foreignKeys}
+	for _, column := range all {
+		typ := fields[column]
+		table.Columns = append(table.Columns, Column{
+			Name:       column,
+			Type:       typ,
+			Nullable:   typ.PkgPath() == "github.com/volatiletech/null/v8",
+			HasDefault: contains(withDefault, column),
+		})
+	}
+
+	return table
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
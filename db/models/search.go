@@ -0,0 +1,247 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+)
+
+// DefaultSearchLanguage is the text search configuration article_search_idx
+// is built with.
+const DefaultSearchLanguage = "english"
+
+// DefaultSearchLimit is the number of results returned when
+// SearchOptions.Limit isn't set.
+const DefaultSearchLimit = 20
+
+// ErrEmptySearchQuery is returned by SearchArticles for queries without any
+// words.
+var ErrEmptySearchQuery = errors.New("dbmodels: search query has no words")
+
+// SearchOptions configures SearchArticles.
+type SearchOptions struct {
+	// Language is the text search configuration, such as "simple" or
+	// "german", DefaultSearchLanguage if empty. Other configurations than
+	// the one article_search_idx is built with work, but can't use the
+	// index.
+	Language string
+	// HeadlineOptions are passed to ts_headline, e.g.
+	// "StartSel=<<, StopSel=>>, MaxFragments=2".
+	HeadlineOptions string
+	// Limit is the maximum number of results, DefaultSearchLimit if zero.
+	Limit  int
+	Offset int
+	// Mods further narrow down the articles, e.g. ArticleWhere.AuthorID.EQ,
+	// or include unpublished ones with WithUnpublished. They must not
+	// select, order, limit or offset.
+	Mods []qm.QueryMod
+}
+
+// ArticleSearchResult is an article found by SearchArticles.
+type ArticleSearchResult struct {
+	Article Article `boil:"article,bind" json:"article"`
+	// Rank orders the results, higher is better. Matches in the title
+	// weigh more than matches in the body.
+	Rank float32 `boil:"rank" json:"rank"`
+	// Headline is the part of the body, or the title for articles without
+	// a body, that matches the query, with the matches highlighted.
+	Headline string `boil:"headline" json:"headline"`
+}
+
+// languagePattern matches the names of text search configurations, which
+// are put into the query as literals so article_search_idx can be used.
+var languagePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// SearchArticles returns the articles matching query, best matches first.
+// query is parsed with ParseSearchQuery. Only published articles are found
+// unless opts.Mods contain WithUnpublished, soft deleted ones never are.
+// The after select hooks of the articles run.
+func SearchArticles(ctx context.Context, exec boil.ContextExecutor, query string, opts SearchOptions) ([]*ArticleSearchResult, error) {
+	tsquery, err := ParseSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	language := opts.Language
+	if language == "" {
+		language = DefaultSearchLanguage
+	}
+	if !languagePattern.MatchString(language) {
+		return nil, errors.New("dbmodels: invalid text search configuration " + language)
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+
+	// This must match the expression of article_search_idx.
+	vector := fmt.Sprintf(
+		`setweight(to_tsvector('%[1]s', "article"."title"), 'A') || setweight(to_tsvector('%[1]s', coalesce("article"."body", '')), 'B')`,
+		language,
+	)
+
+	columns := make([]string, 0, len(articleAllColumns)+2)
+	for _, c := range articleAllColumns {
+		columns = append(columns, fmt.Sprintf(`"article".%q as "article.%s"`, c, c))
+	}
+	columns = append(columns,
+		fmt.Sprintf(`ts_rank_cd(%s, search.query) as "rank"`, vector),
+		fmt.Sprintf(`ts_headline('%s', coalesce("article"."body", "article"."title"), search.query, search.headline_options) as "headline"`, language),
+	)
+
+	mods := []qm.QueryMod{
+		qm.With("search AS (SELECT to_tsquery(?::regconfig, ?) AS query, ?::text AS headline_options)", language, tsquery, opts.HeadlineOptions),
+		qm.Select(columns...),
+		qm.From(`"article"`),
+		qm.From("search"),
+		qm.Where("(" + vector + ") @@ search.query"),
+		qmhelper.WhereIsNull(articleModelTable.deletedAt),
+	}
+	mods = append(mods, publishedScope(opts.Mods)...)
+	mods = append(mods, opts.Mods...)
+	mods = append(mods,
+		qm.OrderBy(`"rank" DESC, "article"."id"`),
+		qm.Limit(limit),
+		qm.Offset(opts.Offset),
+	)
+
+	var results []*ArticleSearchResult
+	if err := NewQuery(mods...).Bind(ctx, exec, &results); err != nil {
+		return nil, errors.Wrap(err, "dbmodels: unable to search article")
+	}
+
+	for _, r := range results {
+		if err := r.Article.doAfterSelectHooks(ctx, exec); err != nil {
+			return results, err
+		}
+	}
+
+	return results, nil
+}
+
+// SearchArticlesG returns the articles matching query using the global
+// executor. See SearchArticles for more documentation.
+func SearchArticlesG(ctx context.Context, query string, opts SearchOptions) ([]*ArticleSearchResult, error) {
+	return SearchArticles(ctx, boil.GetContextDB(), query, opts)
+}
+
+// ParseSearchQuery converts a search query into the syntax of to_tsquery.
+// Queries consist of:
+//
+//	word           articles containing the word, in any form the language
+//	               stems to the same lexeme
+//	word*          articles containing a word starting with word
+//	"some words"   articles containing the words next to each other
+//	-word          articles not containing the word, or phrase
+//	a OR b         articles matching a or b
+//
+// Terms not separated by OR must all match, OR has the lowest precedence.
+// It returns ErrEmptySearchQuery if query has no words.
+func ParseSearchQuery(query string) (string, error) {
+	var (
+		groups [][]string
+		terms  []string
+	)
+
+	rest := strings.TrimSpace(query)
+	for rest != "" {
+		var term string
+		term, rest = nextSearchTerm(rest)
+
+		if term == "OR" {
+			if len(terms) > 0 {
+				groups = append(groups, terms)
+				terms = nil
+			}
+			continue
+		}
+
+		negated := strings.HasPrefix(term, "-")
+		if negated {
+			term = term[1:]
+		}
+
+		var lexemes []string
+		if strings.HasPrefix(term, `"`) {
+			for _, word := range strings.Fields(strings.Trim(term, `"`)) {
+				if lexeme := searchLexeme(word); lexeme != "" {
+					lexemes = append(lexemes, lexeme)
+				}
+			}
+		} else if lexeme := searchLexeme(term); lexeme != "" {
+			lexemes = append(lexemes, lexeme)
+		}
+		if len(lexemes) == 0 {
+			continue
+		}
+
+		expr := strings.Join(lexemes, " <-> ")
+		if len(lexemes) > 1 {
+			expr = "(" + expr + ")"
+		}
+		if negated {
+			expr = "!" + expr
+		}
+		terms = append(terms, expr)
+	}
+	if len(terms) > 0 {
+		groups = append(groups, terms)
+	}
+
+	if len(groups) == 0 {
+		return "", ErrEmptySearchQuery
+	}
+
+	ors := make([]string, len(groups))
+	for i, g := range groups {
+		ors[i] = strings.Join(g, " & ")
+	}
+	return strings.Join(ors, " | "), nil
+}
+
+// nextSearchTerm splits the first term, a word or a quoted phrase optionally
+// prefixed with "-", off s.
+func nextSearchTerm(s string) (term, rest string) {
+	start := 0
+	if strings.HasPrefix(s, "-") {
+		start = 1
+	}
+
+	end := len(s)
+	if strings.HasPrefix(s[start:], `"`) {
+		// Unterminated phrases run to the end of s.
+		if i := strings.IndexByte(s[start+1:], '"'); i >= 0 {
+			end = start + 1 + i + 1
+		}
+	} else if i := strings.IndexFunc(s, unicode.IsSpace); i >= 0 {
+		end = i
+	}
+
+	return s[:end], strings.TrimSpace(s[end:])
+}
+
+// searchLexeme quotes word for to_tsquery, a trailing * turns it into a
+// prefix match. It returns "" if nothing is left of word.
+func searchLexeme(word string) string {
+	prefix := strings.HasSuffix(word, "*")
+	word = strings.TrimRight(word, "*")
+	if word == "" {
+		return ""
+	}
+
+	lexeme := "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(word) + "'"
+	if prefix {
+		lexeme += ":*"
+	}
+	return lexeme
+}
@@ -0,0 +1,144 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+)
+
+// Authors and articles are soft deleted: Delete and DeleteAll set
+// deleted_at unless hardDelete is given, and the generated queries, Find
+// functions and relationship loads leave out deleted rows. qm.WithDeleted
+// lifts that for a query, OnlyDeleted selects nothing but deleted rows.
+
+// OnlyDeleted returns a query mod selecting only the soft deleted rows of
+// the table of T, e.g.
+//
+//	Articles(OnlyDeleted[Article]()).All(ctx, exec)
+func OnlyDeleted[T Model]() qm.QueryMod {
+	t := modelTableOf[T]()
+	if t.deletedAt == "" {
+		panic(fmt.Sprintf("dbmodels: %s isn't soft deleted", t.name))
+	}
+	return onlyDeletedQueryMod{column: t.deletedAt}
+}
+
+type onlyDeletedQueryMod struct {
+	column string
+}
+
+func (m onlyDeletedQueryMod) Apply(q *queries.Query) {
+	qm.WithDeleted().Apply(q)
+	qmhelper.WhereIsNotNull(m.column).Apply(q)
+}
+
+// RestoreG undeletes a soft deleted Article using the global executor.
+func (o *Article) RestoreG(ctx context.Context) (int64, error) {
+	return o.Restore(ctx, boil.GetContextDB())
+}
+
+// Restore undeletes a soft deleted Article by clearing its deleted_at. The
+// update hooks run.
+func (o *Article) Restore(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no Article provided for restore")
+	}
+
+	o.DeletedAt = null.Time{}
+	return o.Update(ctx, exec, boil.Whitelist(ArticleColumns.DeletedAt))
+}
+
+// RestoreG undeletes a soft deleted Author using the global executor.
+func (o *Author) RestoreG(ctx context.Context) (int64, error) {
+	return o.Restore(ctx, boil.GetContextDB())
+}
+
+// Restore undeletes a soft deleted Author by clearing its deleted_at. The
+// update hooks run.
+func (o *Author) Restore(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no Author provided for restore")
+	}
+
+	o.DeletedAt = null.Time{}
+	return o.Update(ctx, exec, boil.Whitelist(AuthorColumns.DeletedAt))
+}
+
+// PurgeArticlesG removes articles deleted before cutoff for good using the
+// global executor.
+func PurgeArticlesG(ctx context.Context, cutoff time.Time) (int64, error) {
+	return PurgeArticles(ctx, boil.GetContextDB(), cutoff)
+}
+
+// PurgeArticles removes the articles soft deleted before cutoff for good and
+// returns how many were removed. The delete hooks don't run, but every
+// removal is recorded in audit_log by the same statement.
+func PurgeArticles(ctx context.Context, exec boil.ContextExecutor, cutoff time.Time) (int64, error) {
+	return purge(ctx, exec, articleModelTable, Articles(
+		OnlyDeleted[Article](),
+		ArticleWhere.DeletedAt.LT(null.TimeFrom(cutoff)),
+	).Query)
+}
+
+// PurgeAuthorsG removes authors deleted before cutoff for good using the
+// global executor.
+func PurgeAuthorsG(ctx context.Context, cutoff time.Time) (int64, error) {
+	return PurgeAuthors(ctx, boil.GetContextDB(), cutoff)
+}
+
+// PurgeAuthors removes the authors soft deleted before cutoff for good and
+// returns how many were removed. Authors that still have articles, deleted
+// or not, are kept so fk_author_id holds; purge the articles first. The
+// delete hooks don't run, but every removal is recorded in audit_log by the
+// same statement.
+func PurgeAuthors(ctx context.Context, exec boil.ContextExecutor, cutoff time.Time) (int64, error) {
+	return purge(ctx, exec, authorModelTable, Authors(
+		OnlyDeleted[Author](),
+		AuthorWhere.DeletedAt.LT(null.TimeFrom(cutoff)),
+		qm.Where(`NOT EXISTS (SELECT 1 FROM "article" WHERE "article"."author_id" = "author"."id")`),
+	).Query)
+}
+
+// purge deletes the rows of table t that q selects and records each in
+// audit_log, with the actor of the context and the row as before, in one
+// statement. It returns the number of rows deleted.
+func purge(ctx context.Context, exec boil.ContextExecutor, t modelTable, q *queries.Query) (int64, error) {
+	queries.SetDelete(q)
+	del, args := queries.BuildQuery(q)
+
+	actor := ActorFrom(ctx)
+	args = append(args, null.NewString(actor, actor != ""), AuditOperationDelete, t.name)
+	n := len(args)
+	query := fmt.Sprintf(`WITH "purged" AS (%s RETURNING *) `+
+		`INSERT INTO "audit_log" ("actor", "operation", "table_name", "row_id", "before", "after") `+
+		`SELECT $%d::varchar, $%d::audit_operation, $%d::varchar, "id", to_jsonb("purged"), NULL FROM "purged"`,
+		strings.TrimSuffix(del, ";"), n-2, n-1, n)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, args)
+	}
+
+	result, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to purge "+t.name)
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by purge of "+t.name)
+	}
+	return rowsAff, nil
+}
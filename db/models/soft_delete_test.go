@@ -0,0 +1,76 @@
+package dbmodels
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPurgeAuthorsAudited(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	cutoff := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectExec(`WITH "purged" AS \(DELETE FROM "author" WHERE .*"author"\."deleted_at" < \$1.*NOT EXISTS .* RETURNING \*\) `+
+		`INSERT INTO "audit_log" \("actor", "operation", "table_name", "row_id", "before", "after"\) `+
+		`SELECT \$2::varchar, \$3::audit_operation, \$4::varchar, "id", to_jsonb\("purged"\), NULL FROM "purged"`).
+		WithArgs(null.TimeFrom(cutoff), null.StringFrom("jane"), AuditOperationDelete, "author").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	n, err := PurgeAuthors(WithActor(context.Background(), "jane"), conn, cutoff)
+	if err != nil {
+		t.Fatalf("PurgeAuthors() = %v", err)
+	}
+	if n != 3 {
+		t.Errorf("PurgeAuthors() purged %d authors, want 3", n)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSoftDeleteArticleVersioned(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// The first delete finds the article changed by someone else, the
+	// second one deletes it like an update.
+	query := `UPDATE "article" SET "deleted_at"=\$1,"updated_at"=\$2 WHERE "id"=\$3 AND "version"=\$4`
+	mock.ExpectExec(query).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(query).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	ctx := boil.SkipHooks(context.Background())
+	article := &Article{ID: 1, Version: 1}
+	var stale *StaleObjectError
+	if _, err := article.Delete(ctx, conn, false); !errors.As(err, &stale) {
+		t.Fatalf("Delete() = %v, want a stale object error", err)
+	}
+	if article.Version != 1 {
+		t.Errorf("stale Delete() changed the version to %d", article.Version)
+	}
+
+	article.Version = 2
+	if _, err := article.Delete(ctx, conn, false); err != nil {
+		t.Fatalf("Delete() = %v", err)
+	}
+	if article.Version != 3 {
+		t.Errorf("Delete() left the version at %d, want 3", article.Version)
+	}
+	if !article.DeletedAt.Valid || !article.UpdatedAt.Equal(article.DeletedAt.Time) {
+		t.Errorf("Delete() set deleted_at %v and updated_at %v, want both now", article.DeletedAt, article.UpdatedAt)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,107 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// modelTable is what the hand-written functions of the package, such as
+// the bulk inserts, Copy, Each and the generic helpers, need to know about
+// a model and its table. Every table has one, added by hand when the
+// table is added, like its Table in schema.go.
+type modelTable struct {
+	name           string
+	typ            reflect.Type
+	mapping        map[string]uint64
+	all            []string
+	withDefault    []string
+	withoutDefault []string
+	// deletedAt is the quoted deleted_at column of soft deleted tables,
+	// empty for tables whose rows are deleted for good.
+	deletedAt string
+	// updatedAt is the quoted updated_at column, empty for tables without
+	// one.
+	updatedAt string
+}
+
+var (
+	articleModelTable = modelTable{
+		name:           TableNames.Article,
+		typ:            articleType,
+		mapping:        articleMapping,
+		all:            articleAllColumns,
+		withDefault:    articleColumnsWithDefault,
+		withoutDefault: articleColumnsWithoutDefault,
+		deletedAt:      `"article"."deleted_at"`,
+		updatedAt:      `"article"."updated_at"`,
+	}
+	authorModelTable = modelTable{
+		name:           TableNames.Author,
+		typ:            authorType,
+		mapping:        authorMapping,
+		all:            authorAllColumns,
+		withDefault:    authorColumnsWithDefault,
+		withoutDefault: authorColumnsWithoutDefault,
+		deletedAt:      `"author"."deleted_at"`,
+		updatedAt:      `"author"."updated_at"`,
+	}
+	tagModelTable = modelTable{
+		name:           TableNames.Tag,
+		typ:            tagType,
+		mapping:        tagMapping,
+		all:            tagAllColumns,
+		withDefault:    tagColumnsWithDefault,
+		withoutDefault: tagColumnsWithoutDefault,
+	}
+	articleAuthorModelTable = modelTable{
+		name:           TableNames.ArticleAuthor,
+		typ:            articleAuthorType,
+		mapping:        articleAuthorMapping,
+		all:            articleAuthorAllColumns,
+		withDefault:    articleAuthorColumnsWithDefault,
+		withoutDefault: articleAuthorColumnsWithoutDefault,
+	}
+	articleRevisionModelTable = modelTable{
+		name:           TableNames.ArticleRevision,
+		typ:            articleRevisionType,
+		mapping:        articleRevisionMapping,
+		all:            articleRevisionAllColumns,
+		withDefault:    articleRevisionColumnsWithDefault,
+		withoutDefault: articleRevisionColumnsWithoutDefault,
+	}
+	auditLogModelTable = modelTable{
+		name:           TableNames.AuditLog,
+		typ:            auditLogType,
+		mapping:        auditLogMapping,
+		all:            auditLogAllColumns,
+		withDefault:    auditLogColumnsWithDefault,
+		withoutDefault: auditLogColumnsWithoutDefault,
+	}
+)
+
+// modelTableOf returns the modelTable of T.
+func modelTableOf[T Model]() modelTable {
+	switch any((*T)(nil)).(type) {
+	case *Article:
+		return articleModelTable
+	case *Author:
+		return authorModelTable
+	case *Tag:
+		return tagModelTable
+	case *ArticleAuthor:
+		return articleAuthorModelTable
+	case *ArticleRevision:
+		return articleRevisionModelTable
+	case *AuditLog:
+		return auditLogModelTable
+	}
+	panic(fmt.Sprintf("dbmodels: no table for %T", (*T)(nil)))
+}
+
+// TableOf returns the name of the table of T.
+func TableOf[T Model]() string {
+	return modelTableOf[T]().name
+}
@@ -0,0 +1,159 @@
+// This file is not generated, it extends the generated models and is kept
+// when sqlboiler regenerates the package (wipe is off in sqlboiler.toml).
+
+package dbmodels
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+)
+
+// Articles and tags are related through the article_tag join table, see
+// the generated Article.Tags, AddTags, SetTags, RemoveTags and LoadTags.
+// The functions below take tag names, which they normalize with
+// NormalizeTagNames.
+
+// NormalizeTagNames trims and lower cases names and drops empty and
+// duplicate ones. The result is sorted.
+func NormalizeTagNames(names []string) []string {
+	seen := map[string]bool{}
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		normalized = append(normalized, name)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// FindOrCreateTagsG returns the tags called names, creating the missing
+// ones, using the global executor.
+func FindOrCreateTagsG(ctx context.Context, names ...string) (TagSlice, error) {
+	return FindOrCreateTags(ctx, boil.GetContextDB(), names...)
+}
+
+// FindOrCreateTags returns the tags called names ordered by name, creating
+// the missing ones, e.g. to pass them to Article.SetTags. The insert hooks
+// don't run for the created tags.
+func FindOrCreateTags(ctx context.Context, exec boil.ContextExecutor, names ...string) (TagSlice, error) {
+	names = NormalizeTagNames(names)
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	insert := `INSERT INTO "tag" ("name") SELECT unnest($1::varchar[]) ON CONFLICT ("name") DO NOTHING`
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, insert)
+		fmt.Fprintln(writer, names)
+	}
+	if _, err := exec.ExecContext(ctx, insert, pq.Array(names)); err != nil {
+		return nil, errors.Wrap(err, "dbmodels: unable to insert into tag")
+	}
+
+	return Tags(TagWhere.Name.IN(names), qm.OrderBy(TagColumns.Name)).All(ctx, exec)
+}
+
+// ArticleHasAnyTag returns a query mod selecting the articles that have at
+// least one of the tags called names, whatever their status, so pages for
+// readers combine it with PublishedArticles:
+//
+//	PublishedArticles(ArticleHasAnyTag("go", "sql")).All(ctx, exec)
+//
+// Without names no article matches.
+func ArticleHasAnyTag(names ...string) qm.QueryMod {
+	names = NormalizeTagNames(names)
+	if len(names) == 0 {
+		return qm.Where("false")
+	}
+
+	return qm.WhereIn(`EXISTS (SELECT 1 FROM "article_tag" INNER JOIN "tag" ON "tag"."id" = "article_tag"."tag_id" WHERE "article_tag"."article_id" = "article"."id" AND "tag"."name" IN ?)`, tagNameArgs(names)...)
+}
+
+// ArticleHasAllTags returns a query mod selecting the articles that have
+// every tag called names, whatever their status like ArticleHasAnyTag.
+// Without names every article matches.
+func ArticleHasAllTags(names ...string) qm.QueryMod {
+	names = NormalizeTagNames(names)
+	if len(names) == 0 {
+		return qm.Where("true")
+	}
+
+	// Tag names are unique and an article has a tag at most once, so an
+	// article having all of them has as many matching rows as there are
+	// names.
+	return qm.WhereIn(fmt.Sprintf(`"article"."id" IN (SELECT "article_tag"."article_id" FROM "article_tag" INNER JOIN "tag" ON "tag"."id" = "article_tag"."tag_id" WHERE "tag"."name" IN ? GROUP BY "article_tag"."article_id" HAVING count(*) = %d)`, len(names)), tagNameArgs(names)...)
+}
+
+func tagNameArgs(names []string) []interface{} {
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		args[i] = name
+	}
+	return args
+}
+
+// TagCount is a tag and the number of articles having it, see TagCloud.
+type TagCount struct {
+	Tag   Tag   `boil:"tag,bind" json:"tag"`
+	Count int64 `boil:"count" json:"count"`
+}
+
+// TagCloudG counts the articles per tag using the global executor. See
+// TagCloud for more documentation.
+func TagCloudG(ctx context.Context, mods ...qm.QueryMod) ([]*TagCount, error) {
+	return TagCloud(ctx, boil.GetContextDB(), mods...)
+}
+
+// TagCloud counts the published articles having each tag, most used tags
+// first. Tags without articles and soft deleted articles are left out.
+// mods narrow down the counted articles, e.g. ArticleWhere.AuthorID.EQ(1),
+// count unpublished ones too with WithUnpublished, or limit the tags with
+// qm.Limit. They must not select, group or order. The after select hooks
+// of the tags run.
+func TagCloud(ctx context.Context, exec boil.ContextExecutor, mods ...qm.QueryMod) ([]*TagCount, error) {
+	columns := make([]string, 0, len(tagAllColumns)+1)
+	for _, c := range tagAllColumns {
+		columns = append(columns, fmt.Sprintf(`"tag".%q as "tag.%s"`, c, c))
+	}
+	columns = append(columns, `count(*) as "count"`)
+
+	q := []qm.QueryMod{
+		qm.Select(columns...),
+		qm.From(`"tag"`),
+		qm.InnerJoin(`"article_tag" ON "article_tag"."tag_id" = "tag"."id"`),
+		qm.InnerJoin(`"article" ON "article"."id" = "article_tag"."article_id"`),
+		qmhelper.WhereIsNull(articleModelTable.deletedAt),
+	}
+	q = append(q, publishedScope(mods)...)
+	q = append(q, mods...)
+	q = append(q,
+		qm.GroupBy(`"tag"."id"`),
+		qm.OrderBy(`"count" DESC, "tag"."name"`),
+	)
+
+	var counts []*TagCount
+	if err := NewQuery(q...).Bind(ctx, exec, &counts); err != nil {
+		return nil, errors.Wrap(err, "dbmodels: unable to count articles per tag")
+	}
+
+	for _, c := range counts {
+		if err := c.Tag.doAfterSelectHooks(ctx, exec); err != nil {
+			return counts, err
+		}
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,275 @@
+// Package pagination pages through the rows of a table with keyset
+// pagination.
+//
+// Instead of skipping rows with OFFSET, which gets slower the further one
+// pages and shows rows twice or not at all when rows are inserted in
+// between, every page continues after the sort key of the last row of the
+// previous page. The key is handed to clients as an opaque cursor token,
+// signed so it can't be forged to probe arbitrary values.
+//
+// Rows are sorted by one column of the table, ties are broken by the id so
+// the order is total. NULLs sort last in either direction.
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+)
+
+// DefaultLimit is the page size used when Params.Limit isn't set.
+const DefaultLimit = 50
+
+var (
+	// ErrInvalidCursor is returned for cursors that weren't issued by the
+	// Signer, or were issued for a different sort order.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidSort is returned when sorting by a column the table
+	// doesn't have.
+	ErrInvalidSort = errors.New("invalid sort")
+)
+
+// Params selects a page.
+type Params struct {
+	// Cursor is the NextCursor or PrevCursor of a previous page, or empty
+	// for the first page.
+	Cursor string
+	// Sort is the column to sort by, prefixed with "-" for descending
+	// order. It defaults to the id and must be the same for every page.
+	Sort string
+	// Limit is the maximum number of items on the page.
+	Limit int
+}
+
+// Page is one page of items.
+type Page[T any] struct {
+	Items []T `json:"items"`
+	// NextCursor and PrevCursor select the pages after and before this
+	// one. They are empty when there is no such page.
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+	// HasMore reports whether there are more items in the direction the
+	// page was requested in: after it for the first page and pages
+	// selected with a NextCursor, before it for pages selected with a
+	// PrevCursor.
+	HasMore bool `json:"has_more"`
+}
+
+// Signer signs and verifies cursor tokens.
+type Signer struct {
+	key []byte
+}
+
+// NewSigner returns a Signer using key. With an empty key a random one is
+// generated, cursors then stop working when the process restarts.
+func NewSigner(key []byte) *Signer {
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			panic(fmt.Sprintf("pagination: unable to generate key: %v", err))
+		}
+	}
+	return &Signer{key: key}
+}
+
+// cursor is the content of a cursor token.
+type cursor struct {
+	Sort  string          `json:"s"`
+	Value json.RawMessage `json:"v,omitempty"`
+	ID    int             `json:"id"`
+	// Prev is set for cursors selecting the page before the row.
+	Prev bool `json:"p,omitempty"`
+}
+
+func (s *Signer) encode(c cursor) (string, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("pagination: unable to encode cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.sign(payload)), nil
+}
+
+func (s *Signer) decode(token string) (cursor, error) {
+	encodedPayload, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return cursor{}, ErrInvalidCursor
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return cursor{}, ErrInvalidCursor
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil || !hmac.Equal(mac, s.sign(payload)) {
+		return cursor{}, ErrInvalidCursor
+	}
+
+	var c cursor
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+func (s *Signer) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// keyset is a resolved page request.
+type keyset struct {
+	table  dbmodels.Table
+	sort   string
+	column dbmodels.Column
+	id     string
+	desc   bool
+	limit  int
+
+	// cursor is nil for the first page. value is the decoded sort key of
+	// the cursor, nil if it is NULL.
+	cursor *cursor
+	value  interface{}
+}
+
+func (s *Signer) resolve(tableName string, p Params) (keyset, error) {
+	table, ok := dbmodels.LookupTable(tableName)
+	if !ok {
+		return keyset{}, fmt.Errorf("pagination: unknown table %s", tableName)
+	}
+	if len(table.PrimaryKey) != 1 {
+		return keyset{}, fmt.Errorf("pagination: %s doesn't have a single column primary key", tableName)
+	}
+
+	k := keyset{table: table, sort: p.Sort, id: table.PrimaryKey[0], limit: p.Limit}
+	if k.limit <= 0 {
+		k.limit = DefaultLimit
+	}
+
+	name := strings.TrimPrefix(p.Sort, "-")
+	k.desc = name != p.Sort
+	if name == "" {
+		name = k.id
+	}
+	k.column, ok = table.Column(name)
+	if !ok {
+		return keyset{}, fmt.Errorf("%w: %s has no column %q", ErrInvalidSort, tableName, name)
+	}
+
+	if p.Cursor == "" {
+		return k, nil
+	}
+
+	c, err := s.decode(p.Cursor)
+	if err != nil {
+		return keyset{}, err
+	}
+	if c.Sort != p.Sort {
+		return keyset{}, fmt.Errorf("%w: cursor was issued for sort %q", ErrInvalidCursor, c.Sort)
+	}
+	k.cursor = &c
+
+	if k.column.Name != k.id {
+		value := reflect.New(k.column.Type)
+		if err := json.Unmarshal(c.Value, value.Interface()); err != nil {
+			return keyset{}, ErrInvalidCursor
+		}
+		if k.value, err = normalize(value.Elem().Interface()); err != nil {
+			return keyset{}, err
+		}
+	}
+
+	return k, nil
+}
+
+// backward reports whether rows are read in reverse order, which is the
+// case for pages before a cursor.
+func (k keyset) backward() bool {
+	return k.cursor != nil && k.cursor.Prev
+}
+
+// page turns the rows read for k, at most limit+1 in reading order, into a
+// Page.
+func page[T any](s *Signer, k keyset, items []T) (Page[T], error) {
+	p := Page[T]{Items: items, HasMore: len(items) > k.limit}
+	if p.HasMore {
+		p.Items = p.Items[:k.limit]
+	}
+	if p.Items == nil {
+		p.Items = []T{}
+	}
+
+	if k.backward() {
+		for i, j := 0, len(p.Items)-1; i < j; i, j = i+1, j-1 {
+			p.Items[i], p.Items[j] = p.Items[j], p.Items[i]
+		}
+	}
+	if len(p.Items) == 0 {
+		return p, nil
+	}
+
+	// Coming from a cursor means there are items on the other side of it.
+	var err error
+	if p.HasMore || k.backward() {
+		if p.NextCursor, err = s.cursorFor(k, p.Items[len(p.Items)-1], false); err != nil {
+			return Page[T]{}, err
+		}
+	}
+	if (k.backward() && p.HasMore) || (k.cursor != nil && !k.backward()) {
+		if p.PrevCursor, err = s.cursorFor(k, p.Items[0], true); err != nil {
+			return Page[T]{}, err
+		}
+	}
+
+	return p, nil
+}
+
+func (s *Signer) cursorFor(k keyset, item interface{}, prev bool) (string, error) {
+	c := cursor{Sort: k.sort, Prev: prev}
+
+	id, ok := field(item, k.id).(int)
+	if !ok {
+		return "", fmt.Errorf("pagination: %s.%s isn't an int", k.table.Name, k.id)
+	}
+	c.ID = id
+
+	if k.column.Name != k.id {
+		value, err := json.Marshal(field(item, k.column.Name))
+		if err != nil {
+			return "", fmt.Errorf("pagination: unable to encode cursor: %w", err)
+		}
+		c.Value = value
+	}
+
+	return s.encode(c)
+}
+
+// field returns the value of the field of the model item holding column.
+func field(item interface{}, column string) interface{} {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if strings.Split(t.Field(i).Tag.Get("boil"), ",")[0] == column {
+			return v.Field(i).Interface()
+		}
+	}
+	return nil
+}
+
+// normalize converts a model field value into one of the driver.Value
+// types, nil for NULL.
+func normalize(v interface{}) (interface{}, error) {
+	if valuer, ok := v.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(v)
+}
@@ -0,0 +1,213 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var signer = NewSigner([]byte("secret"))
+
+// articles returns articles 1 to 5, two of them never published and two
+// published at the same time.
+func articles() []*dbmodels.Article {
+	day := func(d int) null.Time {
+		return null.TimeFrom(time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC))
+	}
+	return []*dbmodels.Article{
+		{ID: 3, Title: "c", PublishedAt: day(2)},
+		{ID: 1, Title: "a", PublishedAt: day(1)},
+		{ID: 5, Title: "e"},
+		{ID: 4, Title: "d", PublishedAt: day(1)},
+		{ID: 2, Title: "b"},
+	}
+}
+
+func ids(items []*dbmodels.Article) []int {
+	ids := make([]int, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func TestSlice(t *testing.T) {
+	tests := []struct {
+		sort string
+		// pages are the ids on the pages of two articles, NULLs last in
+		// either direction.
+		pages [][]int
+	}{
+		{"", [][]int{{1, 2}, {3, 4}, {5}}},
+		{"-id", [][]int{{5, 4}, {3, 2}, {1}}},
+		{"published_at", [][]int{{1, 4}, {3, 2}, {5}}},
+		{"-published_at", [][]int{{3, 4}, {1, 5}, {2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			// Forward from the first page to the last one.
+			var pages [][]int
+			var last Page[*dbmodels.Article]
+			p := Params{Sort: tt.sort, Limit: 2}
+			for {
+				page, err := Slice(signer, dbmodels.TableNames.Article, p, articles())
+				if err != nil {
+					t.Fatalf("Slice(%+v) = %v", p, err)
+				}
+				pages = append(pages, ids(page.Items))
+				if (page.NextCursor != "") != page.HasMore {
+					t.Errorf("page %v has more: %v, next cursor %q", ids(page.Items), page.HasMore, page.NextCursor)
+				}
+				if (page.PrevCursor != "") != (len(pages) > 1) {
+					t.Errorf("page %v has prev cursor %q", ids(page.Items), page.PrevCursor)
+				}
+				last = page
+				if page.NextCursor == "" || len(pages) > len(tt.pages) {
+					break
+				}
+				p.Cursor = page.NextCursor
+			}
+			if !reflect.DeepEqual(pages, tt.pages) {
+				t.Fatalf("pages = %v, want %v", pages, tt.pages)
+			}
+
+			// And backward to the first one again.
+			pages = pages[:0]
+			p.Cursor = last.PrevCursor
+			for p.Cursor != "" && len(pages) < len(tt.pages) {
+				page, err := Slice(signer, dbmodels.TableNames.Article, p, articles())
+				if err != nil {
+					t.Fatalf("Slice(%+v) = %v", p, err)
+				}
+				if page.NextCursor == "" {
+					t.Errorf("page %v before a cursor has no next cursor", ids(page.Items))
+				}
+				pages = append([][]int{ids(page.Items)}, pages...)
+				p.Cursor = page.PrevCursor
+			}
+			if want := tt.pages[:len(tt.pages)-1]; !reflect.DeepEqual(pages, want) {
+				t.Fatalf("pages backward = %v, want %v", pages, want)
+			}
+		})
+	}
+}
+
+// cursorFor returns the cursor token of c, signed by signer.
+func cursorFor(t *testing.T, c cursor) string {
+	t.Helper()
+	token, err := signer.encode(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestKeysetMods(t *testing.T) {
+	published := json.RawMessage(`"2024-01-01T00:00:00Z"`)
+	tests := []struct {
+		name   string
+		params Params
+		query  string
+	}{
+		{
+			"first page",
+			Params{Sort: "published_at", Limit: 2},
+			`SELECT * FROM "article" ORDER BY "article"."published_at" ASC NULLS LAST, "article"."id" ASC LIMIT 3;`,
+		},
+		{
+			"after id",
+			Params{Sort: "-id", Limit: 2, Cursor: cursorFor(t, cursor{Sort: "-id", ID: 4})},
+			`SELECT * FROM "article" WHERE ("article"."id" < $1) ORDER BY "article"."id" DESC LIMIT 3;`,
+		},
+		{
+			"after value",
+			Params{Sort: "published_at", Limit: 2, Cursor: cursorFor(t, cursor{Sort: "published_at", Value: published, ID: 4})},
+			`SELECT * FROM "article" WHERE (("article"."published_at" > $1 OR ("article"."published_at" = $2 AND "article"."id" > $3) OR "article"."published_at" IS NULL)) ` +
+				`ORDER BY "article"."published_at" ASC NULLS LAST, "article"."id" ASC LIMIT 3;`,
+		},
+		{
+			"after NULL",
+			Params{Sort: "published_at", Limit: 2, Cursor: cursorFor(t, cursor{Sort: "published_at", Value: json.RawMessage(`null`), ID: 2})},
+			`SELECT * FROM "article" WHERE ("article"."published_at" IS NULL AND "article"."id" > $1) ` +
+				`ORDER BY "article"."published_at" ASC NULLS LAST, "article"."id" ASC LIMIT 3;`,
+		},
+		{
+			"before value",
+			Params{Sort: "-published_at", Limit: 2, Cursor: cursorFor(t, cursor{Sort: "-published_at", Value: published, ID: 4, Prev: true})},
+			`SELECT * FROM "article" WHERE (("article"."published_at" > $1 OR ("article"."published_at" = $2 AND "article"."id" > $3))) ` +
+				`ORDER BY "article"."published_at" ASC NULLS FIRST, "article"."id" ASC LIMIT 3;`,
+		},
+		{
+			"before NULL",
+			Params{Sort: "published_at", Limit: 2, Cursor: cursorFor(t, cursor{Sort: "published_at", Value: json.RawMessage(`null`), ID: 5, Prev: true})},
+			`SELECT * FROM "article" WHERE (("article"."published_at" IS NOT NULL OR "article"."id" < $1)) ` +
+				`ORDER BY "article"."published_at" DESC NULLS FIRST, "article"."id" DESC LIMIT 3;`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := signer.resolve(dbmodels.TableNames.Article, tt.params)
+			if err != nil {
+				t.Fatalf("resolve() = %v", err)
+			}
+			mods := append([]qm.QueryMod{qm.From(`"article"`)}, k.mods()...)
+			if query, _ := queries.BuildQuery(dbmodels.NewQuery(mods...)); query != tt.query {
+				t.Errorf("query = %s\nwant %s", query, tt.query)
+			}
+		})
+	}
+}
+
+func TestInvalidCursor(t *testing.T) {
+	valid := cursorFor(t, cursor{Sort: "title", Value: json.RawMessage(`"b"`), ID: 2})
+	payload, mac, _ := strings.Cut(valid, ".")
+
+	// tampered carries the MAC of valid for another value.
+	tampered := base64.RawURLEncoding.EncodeToString([]byte(`{"s":"title","v":"z","id":2}`)) + "." + mac
+	forged, err := NewSigner([]byte("other")).encode(cursor{Sort: "title", Value: json.RawMessage(`"b"`), ID: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		params Params
+		err    error
+	}{
+		{"tampered", Params{Sort: "title", Cursor: tampered}, ErrInvalidCursor},
+		{"other key", Params{Sort: "title", Cursor: forged}, ErrInvalidCursor},
+		{"no mac", Params{Sort: "title", Cursor: payload}, ErrInvalidCursor},
+		{"not base64", Params{Sort: "title", Cursor: "!!." + mac}, ErrInvalidCursor},
+		{"other sort", Params{Sort: "-title", Cursor: valid}, ErrInvalidCursor},
+		{"default sort", Params{Cursor: valid}, ErrInvalidCursor},
+		{
+			"value of another type",
+			Params{Sort: "published_at", Cursor: cursorFor(t, cursor{Sort: "published_at", Value: json.RawMessage(`"b"`), ID: 2})},
+			ErrInvalidCursor,
+		},
+		{"unknown sort", Params{Sort: "nope"}, ErrInvalidSort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Slice(signer, dbmodels.TableNames.Article, tt.params, articles()); !errors.Is(err, tt.err) {
+				t.Errorf("Slice() = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	if _, err := Slice(signer, dbmodels.TableNames.Article, Params{Sort: "title", Cursor: valid}, articles()); err != nil {
+		t.Errorf("Slice() with the untampered cursor = %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package pagination
+
+import (
+	"context"
+	"fmt"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Articles returns the page of the articles matching mods selected by p.
+// mods must not order, limit or offset the query.
+func (s *Signer) Articles(ctx context.Context, exec boil.ContextExecutor, p Params, mods ...qm.QueryMod) (Page[*dbmodels.Article], error) {
+	return Find[dbmodels.Article](ctx, exec, s, p, mods...)
+}
+
+// Authors returns the page of the authors matching mods selected by p.
+// mods must not order, limit or offset the query.
+func (s *Signer) Authors(ctx context.Context, exec boil.ContextExecutor, p Params, mods ...qm.QueryMod) (Page[*dbmodels.Author], error) {
+	return Find[dbmodels.Author](ctx, exec, s, p, mods...)
+}
+
+// Find returns the page of the rows of the table of T matching mods
+// selected by p, signing its cursors with s. mods must not order, limit or
+// offset the query.
+func Find[T dbmodels.Model](ctx context.Context, exec boil.ContextExecutor, s *Signer, p Params, mods ...qm.QueryMod) (Page[*T], error) {
+	k, err := s.resolve(dbmodels.TableOf[T](), p)
+	if err != nil {
+		return Page[*T]{}, err
+	}
+
+	items, err := dbmodels.Find[T](ctx, exec, append(append([]qm.QueryMod{}, mods...), k.mods()...)...)
+	if err != nil {
+		return Page[*T]{}, err
+	}
+
+	return page(s, k, items)
+}
+
+// mods returns the query mods reading the rows of the page in reading
+// order, one more than the limit to find out whether there are more.
+func (k keyset) mods() []qm.QueryMod {
+	// Reading backwards reverses the order, which also moves NULLs first.
+	desc, nullsLast := k.desc, true
+	if k.backward() {
+		desc, nullsLast = !desc, false
+	}
+
+	dir, op := "ASC", ">"
+	if desc {
+		dir, op = "DESC", "<"
+	}
+	nulls := "NULLS LAST"
+	if !nullsLast {
+		nulls = "NULLS FIRST"
+	}
+
+	id := quote(k.table.Name, k.id)
+	col := quote(k.table.Name, k.column.Name)
+
+	var mods []qm.QueryMod
+	if k.cursor != nil {
+		switch {
+		case k.column.Name == k.id:
+			mods = append(mods, qm.Where(fmt.Sprintf("%s %s ?", id, op), k.cursor.ID))
+		case k.value == nil && nullsLast:
+			// Only NULLs remain after a NULL.
+			mods = append(mods, qm.Where(fmt.Sprintf("%s IS NULL AND %s %s ?", col, id, op), k.cursor.ID))
+		case k.value == nil:
+			mods = append(mods, qm.Where(fmt.Sprintf("(%s IS NOT NULL OR %s %s ?)", col, id, op), k.cursor.ID))
+		default:
+			clause := fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?)", col, op, col, id, op)
+			if nullsLast {
+				clause += fmt.Sprintf(" OR %s IS NULL", col)
+			}
+			mods = append(mods, qm.Where(clause+")", k.value, k.value, k.cursor.ID))
+		}
+	}
+
+	if k.column.Name == k.id {
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s %s", id, dir)))
+	} else {
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s %s %s, %s %s", col, dir, nulls, id, dir)))
+	}
+
+	return append(mods, qm.Limit(k.limit+1))
+}
+
+func quote(table, column string) string {
+	return fmt.Sprintf(`"%s"."%s"`, table, column)
+}
@@ -0,0 +1,153 @@
+package pagination
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Slice returns the page of items selected by p, with the same ordering and
+// cursors as a query against table would. items are models of table, such
+// as []*dbmodels.Article, in any order. It backs in-memory
+// implementations of the repositories.
+func Slice[T any](s *Signer, table string, p Params, items []T) (Page[T], error) {
+	k, err := s.resolve(table, p)
+	if err != nil {
+		return Page[T]{}, err
+	}
+
+	type keyed struct {
+		item  T
+		value interface{}
+		id    int
+	}
+
+	rows := make([]keyed, 0, len(items))
+	for _, item := range items {
+		row := keyed{item: item}
+		row.id, _ = field(item, k.id).(int)
+		if k.column.Name != k.id {
+			if row.value, err = normalize(field(item, k.column.Name)); err != nil {
+				return Page[T]{}, err
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	// compare orders a before b in reading order, see keyset.mods.
+	compare := func(aValue interface{}, aID int, bValue interface{}, bID int) int {
+		c := 0
+		if k.column.Name != k.id {
+			c = compareNullsLast(aValue, bValue, k.desc)
+		}
+		if c == 0 {
+			c = compareInts(aID, bID)
+			if k.desc {
+				c = -c
+			}
+		}
+		if k.backward() {
+			c = -c
+		}
+		return c
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return compare(rows[i].value, rows[i].id, rows[j].value, rows[j].id) < 0
+	})
+
+	var read []T
+	for _, row := range rows {
+		if k.cursor != nil && compare(row.value, row.id, k.value, k.cursor.ID) <= 0 {
+			continue
+		}
+		read = append(read, row.item)
+		if len(read) > k.limit {
+			break
+		}
+	}
+
+	return page(s, k, read)
+}
+
+// compareNullsLast compares two normalized values, sorting NULLs after
+// everything else regardless of desc.
+func compareNullsLast(a, b interface{}, desc bool) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+
+	c := compareValues(a, b)
+	if desc {
+		c = -c
+	}
+	return c
+}
+
+func compareValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case int64:
+		b := b.(int64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case float64:
+		b := b.(float64)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case bool:
+		b := b.(bool)
+		switch {
+		case a == b:
+			return 0
+		case !a:
+			return -1
+		}
+		return 1
+	case string:
+		switch b := b.(string); {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case []byte:
+		return bytes.Compare(a, b.([]byte))
+	case time.Time:
+		b := b.(time.Time)
+		switch {
+		case a.Before(b):
+			return -1
+		case a.After(b):
+			return 1
+		}
+		return 0
+	}
+	panic(fmt.Sprintf("pagination: can't compare %T", a))
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,324 @@
+// Package schema compares the tables the models in db/models were generated
+// from against a live database.
+//
+// The generated models hard-code the columns, defaults and keys of every
+// table. When the database is altered without regenerating them, queries
+// fail at runtime or, worse, silently skip columns. Check reports such
+// drift up front: missing tables, missing and extra columns, columns whose
+// type, nullability or default changed, and changed primary or foreign
+// keys.
+package schema
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Kinds of drift reported by Check.
+const (
+	KindMissingTable  = "missing table"
+	KindMissingColumn = "missing column"
+	KindExtraColumn   = "extra column"
+	KindType          = "type mismatch"
+	KindNullable      = "nullability mismatch"
+	KindDefault       = "default mismatch"
+	KindPrimaryKey    = "primary key mismatch"
+	KindForeignKey    = "foreign key mismatch"
+)
+
+// Problem is a difference between the models and the database.
+type Problem struct {
+	Table  string `json:"table"`
+	Column string `json:"column,omitempty"`
+	Kind   string `json:"kind"`
+	Detail string `json:"detail"`
+}
+
+func (p Problem) String() string {
+	name := p.Table
+	if p.Column != "" {
+		name += "." + p.Column
+	}
+	return fmt.Sprintf("%s: %s: %s", name, p.Kind, p.Detail)
+}
+
+// DriftError is returned by Verify when the database doesn't match the
+// models.
+type DriftError struct {
+	Problems []Problem
+}
+
+func (e *DriftError) Error() string {
+	lines := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		lines[i] = "  " + p.String()
+	}
+	return fmt.Sprintf("schema: database doesn't match the generated models, run the migrations or regenerate the models:\n%s", strings.Join(lines, "\n"))
+}
+
+// Verify runs Check and turns the problems it finds into a *DriftError.
+func Verify(ctx context.Context, exec boil.ContextExecutor) error {
+	problems, err := Check(ctx, exec)
+	if err != nil {
+		return err
+	}
+	if len(problems) > 0 {
+		return &DriftError{Problems: problems}
+	}
+	return nil
+}
+
+// Check compares dbmodels.Tables against the tables in the current schema
+// of the database and returns the differences, ordered by table.
+func Check(ctx context.Context, exec boil.ContextExecutor) ([]Problem, error) {
+	problems := []Problem{}
+	for _, table := range dbmodels.Tables {
+		p, err := checkTable(ctx, exec, table)
+		if err != nil {
+			return nil, err
+		}
+		problems = append(problems, p...)
+	}
+	return problems, nil
+}
+
+type column struct {
+	name       string
+	dataType   string
+	nullable   bool
+	hasDefault bool
+}
+
+type foreignKey struct {
+	column        string
+	foreignTable  string
+	foreignColumn string
+}
+
+func checkTable(ctx context.Context, exec boil.ContextExecutor, table dbmodels.Table) ([]Problem, error) {
+	columns, err := readColumns(ctx, exec, table.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns.ordered) == 0 {
+		return []Problem{{Table: table.Name, Kind: KindMissingTable, Detail: "table doesn't exist"}}, nil
+	}
+
+	var problems []Problem
+	add := func(column, kind, format string, args ...interface{}) {
+		problems = append(problems, Problem{Table: table.Name, Column: column, Kind: kind, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	known := map[string]bool{}
+	for _, want := range table.Columns {
+		known[want.Name] = true
+
+		got, ok := columns.byName[want.Name]
+		if !ok {
+			add(want.Name, KindMissingColumn, "column doesn't exist")
+			continue
+		}
+		if types, ok := compatibleTypes(want.Type); ok && !contains(types, got.dataType) {
+			add(want.Name, KindType, "model uses %s, database has %s", want.Type, got.dataType)
+		}
+		if want.Nullable != got.nullable {
+			add(want.Name, KindNullable, "model nullable: %t, database nullable: %t", want.Nullable, got.nullable)
+		}
+		if want.HasDefault != got.hasDefault {
+			add(want.Name, KindDefault, "model has default: %t, database has default: %t", want.HasDefault, got.hasDefault)
+		}
+	}
+	for _, got := range columns.ordered {
+		if !known[got.name] {
+			add(got.name, KindExtraColumn, "column isn't in the model (%s)", got.dataType)
+		}
+	}
+
+	primaryKey, err := readPrimaryKey(ctx, exec, table.Name)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Join(primaryKey, ",") != strings.Join(table.PrimaryKey, ",") {
+		add("", KindPrimaryKey, "model has (%s), database has (%s)", strings.Join(table.PrimaryKey, ", "), strings.Join(primaryKey, ", "))
+	}
+
+	foreignKeys, err := readForeignKeys(ctx, exec, table.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, want := range table.ForeignKeys {
+		got, ok := foreignKeys[want.Name]
+		if !ok {
+			add(want.Column, KindForeignKey, "foreign key %s doesn't exist", want.Name)
+			continue
+		}
+		if got != (foreignKey{want.Column, want.ForeignTable, want.ForeignColumn}) {
+			add(want.Column, KindForeignKey, "model has %s(%s) referencing %s(%s), database has %s(%s) referencing %s(%s)",
+				want.Name, want.Column, want.ForeignTable, want.ForeignColumn,
+				want.Name, got.column, got.foreignTable, got.foreignColumn)
+		}
+	}
+
+	return problems, nil
+}
+
+// columnMap holds the columns of a table by name and in table order.
+type columnMap struct {
+	byName  map[string]column
+	ordered []column
+}
+
+// readColumns returns the columns of table, none if the table doesn't
+// exist.
+func readColumns(ctx context.Context, exec boil.ContextExecutor, table string) (columnMap, error) {
+	rows, err := exec.QueryContext(ctx, `
+		SELECT column_name, data_type, is_nullable = 'YES', column_default IS NOT NULL OR is_identity = 'YES'
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1
+		ORDER BY ordinal_position`, table)
+	if err != nil {
+		return columnMap{}, fmt.Errorf("schema: unable to read columns of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	columns := columnMap{byName: map[string]column{}}
+	for rows.Next() {
+		var c column
+		if err := rows.Scan(&c.name, &c.dataType, &c.nullable, &c.hasDefault); err != nil {
+			return columnMap{}, fmt.Errorf("schema: unable to read columns of %s: %w", table, err)
+		}
+		// sqlboiler counts NULL as the default of nullable columns.
+		c.hasDefault = c.hasDefault || c.nullable
+		columns.byName[c.name] = c
+		columns.ordered = append(columns.ordered, c)
+	}
+	if err := rows.Err(); err != nil {
+		return columnMap{}, fmt.Errorf("schema: unable to read columns of %s: %w", table, err)
+	}
+
+	return columns, nil
+}
+
+// readPrimaryKey returns the primary key columns of table in key order.
+func readPrimaryKey(ctx context.Context, exec boil.ContextExecutor, table string) ([]string, error) {
+	rows, err := exec.QueryContext(ctx, `
+		SELECT a.attname
+		FROM pg_index i
+		JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = to_regclass($1) AND i.indisprimary
+		ORDER BY array_position(i.indkey::int2[], a.attnum)`, table)
+	if err != nil {
+		return nil, fmt.Errorf("schema: unable to read primary key of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, fmt.Errorf("schema: unable to read primary key of %s: %w", table, err)
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("schema: unable to read primary key of %s: %w", table, err)
+	}
+
+	return columns, nil
+}
+
+// readForeignKeys returns the single column foreign keys of table by
+// constraint name.
+func readForeignKeys(ctx context.Context, exec boil.ContextExecutor, table string) (map[string]foreignKey, error) {
+	rows, err := exec.QueryContext(ctx, `
+		SELECT c.conname, a.attname, ft.relname, fa.attname
+		FROM pg_constraint c
+		JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = c.conkey[1]
+		JOIN pg_class ft ON ft.oid = c.confrelid
+		JOIN pg_attribute fa ON fa.attrelid = c.confrelid AND fa.attnum = c.confkey[1]
+		WHERE c.contype = 'f' AND c.conrelid = to_regclass($1) AND cardinality(c.conkey) = 1`, table)
+	if err != nil {
+		return nil, fmt.Errorf("schema: unable to read foreign keys of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	foreignKeys := map[string]foreignKey{}
+	for rows.Next() {
+		var name string
+		var fk foreignKey
+		if err := rows.Scan(&name, &fk.column, &fk.foreignTable, &fk.foreignColumn); err != nil {
+			return nil, fmt.Errorf("schema: unable to read foreign keys of %s: %w", table, err)
+		}
+		foreignKeys[name] = fk
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("schema: unable to read foreign keys of %s: %w", table, err)
+	}
+
+	return foreignKeys, nil
+}
+
+// dataTypes maps the Go types sqlboiler generates to the information_schema
+// data types they are generated from. null.* types map like the type they
+// wrap.
+var dataTypes = map[string][]string{
+	"int":       {"integer"},
+	"int16":     {"smallint"},
+	"int32":     {"integer"},
+	"int64":     {"bigint"},
+	"float32":   {"real"},
+	"float64":   {"double precision"},
+	"bool":      {"boolean"},
+	"string":    {"character varying", "character", "text", "uuid", "USER-DEFINED"},
+	"[]byte":    {"bytea"},
+	"time.Time": {"timestamp without time zone", "timestamp with time zone", "date"},
+	"json":      {"json", "jsonb"},
+}
+
+var nullTypes = map[string]string{
+	"Int":     "int",
+	"Int16":   "int16",
+	"Int32":   "int32",
+	"Int64":   "int64",
+	"Float32": "float32",
+	"Float64": "float64",
+	"Bool":    "bool",
+	"String":  "string",
+	"Bytes":   "[]byte",
+	"Time":    "time.Time",
+	"JSON":    "json",
+}
+
+// compatibleTypes returns the data types a column held in t may have. ok is
+// false for types the check doesn't know, those aren't checked.
+func compatibleTypes(t reflect.Type) (types []string, ok bool) {
+	if t == nil {
+		return nil, false
+	}
+
+	name := t.String()
+	if t.PkgPath() == "github.com/volatiletech/null/v8" {
+		name = nullTypes[t.Name()]
+	} else if t.PkgPath() != "" && t.PkgPath() != "time" {
+		// Named types such as generated enums are checked by their
+		// underlying type.
+		name = t.Kind().String()
+	}
+
+	types, ok = dataTypes[name]
+	return types, ok
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
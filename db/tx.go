@@ -0,0 +1,178 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Postgres error codes for transactions that may succeed when retried.
+const (
+	pqSerializationFailure pq.ErrorCode = "40001"
+	pqDeadlockDetected     pq.ErrorCode = "40P01"
+)
+
+// Tx is the executor passed to the function run by WithTx. Passing it to
+// WithTx again nests the inner function in a savepoint.
+type Tx struct {
+	*sql.Tx
+
+	// savepoints counts the savepoints created so far, so nested calls
+	// get unique names.
+	savepoints *int
+}
+
+type txOptions struct {
+	sql.TxOptions
+
+	maxRetries int
+	backoff    time.Duration
+}
+
+// TxOption configures a transaction started by WithTx.
+type TxOption func(*txOptions)
+
+// Isolation sets the isolation level of the transaction.
+func Isolation(level sql.IsolationLevel) TxOption {
+	return func(o *txOptions) { o.Isolation = level }
+}
+
+// ReadOnly starts a read-only transaction.
+func ReadOnly() TxOption {
+	return func(o *txOptions) { o.ReadOnly = true }
+}
+
+// MaxRetries sets how many times the transaction is retried after a
+// serialization failure or deadlock. The default is 3.
+func MaxRetries(n int) TxOption {
+	return func(o *txOptions) { o.maxRetries = n }
+}
+
+// RetryBackoff sets the initial wait before retrying, it doubles after every
+// attempt. The default is 50ms, zero or less retries right away.
+func RetryBackoff(d time.Duration) TxOption {
+	if d < 0 {
+		d = 0
+	}
+	return func(o *txOptions) { o.backoff = d }
+}
+
+// WithTx runs fn inside a transaction on exec. The transaction is committed
+// if fn returns nil and rolled back if it returns an error or panics.
+//
+// When exec is a *sql.DB (or any boil.ContextBeginner) a new transaction is
+// started. Transactions that fail with a serialization failure (40001) or a
+// deadlock (40P01) are retried from the start, so fn must be safe to run
+// more than once.
+//
+// When exec is the *Tx of an enclosing WithTx, fn runs inside a savepoint
+// instead: an error rolls back only the work done by fn and is returned to
+// the enclosing function. Options are ignored for savepoints and retrying
+// is left to the outermost call.
+func WithTx(ctx context.Context, exec boil.ContextExecutor, fn func(tx boil.ContextExecutor) error, opts ...TxOption) error {
+	if tx, ok := exec.(*Tx); ok {
+		return withSavepoint(ctx, tx, fn)
+	}
+
+	beginner, ok := exec.(boil.ContextBeginner)
+	if !ok {
+		return fmt.Errorf("db: %T can't begin transactions", exec)
+	}
+
+	o := txOptions{maxRetries: 3, backoff: 50 * time.Millisecond}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	backoff := o.backoff
+	for attempt := 0; ; attempt++ {
+		err := runTx(ctx, beginner, &o.TxOptions, fn)
+		if err == nil || !IsRetryable(err) || attempt >= o.maxRetries {
+			return err
+		}
+
+		// Jitter keeps conflicting transactions from retrying in lockstep.
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		// Stop doubling before the wait overflows.
+		if backoff*2 > backoff {
+			backoff *= 2
+		}
+	}
+}
+
+func runTx(ctx context.Context, beginner boil.ContextBeginner, opts *sql.TxOptions, fn func(tx boil.ContextExecutor) error) (err error) {
+	sqlTx, err := beginner.BeginTx(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("db: unable to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			sqlTx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(&Tx{Tx: sqlTx, savepoints: new(int)}); err != nil {
+		if rbErr := sqlTx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if err := sqlTx.Commit(); err != nil {
+		return fmt.Errorf("db: unable to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func withSavepoint(ctx context.Context, tx *Tx, fn func(tx boil.ContextExecutor) error) (err error) {
+	*tx.savepoints++
+	name := fmt.Sprintf("sp_%d", *tx.savepoints)
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("db: unable to create savepoint: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("%w (rollback to savepoint failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("db: unable to release savepoint: %w", err)
+	}
+
+	return nil
+}
+
+// IsRetryable reports whether err is a serialization failure or deadlock,
+// after which the whole transaction may succeed when run again.
+func IsRetryable(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == pqSerializationFailure || pqErr.Code == pqDeadlockDetected
+}
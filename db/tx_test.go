@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestWithTxRetries(t *testing.T) {
+	for _, backoff := range []time.Duration{-time.Second, -2, 0, time.Nanosecond} {
+		t.Run(backoff.String(), func(t *testing.T) {
+			conn, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			serialization := &pq.Error{Code: pqSerializationFailure}
+			for i := 0; i < 2; i++ {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE").WillReturnError(serialization)
+				mock.ExpectRollback()
+			}
+			mock.ExpectBegin()
+			mock.ExpectExec("UPDATE").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			attempts := 0
+			err = WithTx(context.Background(), conn, func(tx boil.ContextExecutor) error {
+				attempts++
+				_, err := tx.ExecContext(context.Background(), "UPDATE author SET name = 'x'")
+				return err
+			}, RetryBackoff(backoff))
+			if err != nil {
+				t.Fatalf("WithTx() = %v", err)
+			}
+			if attempts != 3 {
+				t.Errorf("attempts = %d, want 3", attempts)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestWithTxGivesUp(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	deadlock := &pq.Error{Code: pqDeadlockDetected}
+	for i := 0; i < 2; i++ {
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+	}
+
+	err = WithTx(context.Background(), conn, func(tx boil.ContextExecutor) error {
+		return deadlock
+	}, MaxRetries(1), RetryBackoff(0))
+	if !errors.Is(err, deadlock) {
+		t.Fatalf("WithTx() = %v, want the deadlock", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWithTxSavepoint(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	failed := errors.New("failed")
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVEPOINT sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err = WithTx(context.Background(), conn, func(tx boil.ContextExecutor) error {
+		if err := WithTx(context.Background(), tx, func(boil.ContextExecutor) error { return failed }); !errors.Is(err, failed) {
+			t.Errorf("first savepoint = %v, want %v", err, failed)
+		}
+		return WithTx(context.Background(), tx, func(boil.ContextExecutor) error { return nil })
+	})
+	if err != nil {
+		t.Fatalf("WithTx() = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func newDemoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "demo",
+		Short: "Run the walkthrough from the SQLBoiler article",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+
+			// Create the author and their articles atomically, either all
+			// rows are inserted or none.
+			var author dbmodels.Author
+			err := db.WithTx(ctx, boil.GetContextDB(), func(tx boil.ContextExecutor) error {
+				var err error
+				if author, err = createAuthor(ctx, tx); err != nil {
+					return err
+				}
+				if _, err = createArticle(ctx, tx, author); err != nil {
+					return err
+				}
+				_, err = createArticle(ctx, tx, author)
+				return err
+			})
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			selectAuthorWithArticleJoin(ctx, author.ID)
+		},
+	}
+}
+
+func createAuthor(ctx context.Context, exec boil.ContextExecutor) (dbmodels.Author, error) {
+	author := dbmodels.Author{
+		Name:  "John Doe",
+		Email: "johndoe@email.com",
+	}
+
+	err := author.Insert(ctx, exec, boil.Infer())
+	return author, err
+}
+
+func createArticle(ctx context.Context, exec boil.ContextExecutor, author dbmodels.Author) (dbmodels.Article, error) {
+	article := dbmodels.Article{
+		Title:    "Hello World",
+		Body:     null.StringFrom("Hello world, this is an article."),
+		AuthorID: author.ID,
+	}
+
+	err := article.Insert(ctx, exec, boil.Infer())
+	return article, err
+}
+
+func selectAuthorWithArticle(ctx context.Context, authorID int) {
+	author, err := dbmodels.Authors(dbmodels.AuthorWhere.ID.EQ(authorID)).OneG(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Author: \n\tID:%d \n\tName:%s \n\tEmail:%s\n", author.ID, author.Name, author.Email)
+
+	articles, err := author.Articles().AllG(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, a := range articles {
+		fmt.Printf("Article: \n\tID:%d \n\tTitle:%s \n\tBody:%s \n\tCreatedAt:%v\n", a.ID, a.Title, a.Body.String, a.CreatedAt.Time)
+	}
+}
+
+func selectAuthorWithArticleEager(ctx context.Context, authorID int) {
+	author, err := dbmodels.Authors(
+		dbmodels.AuthorWhere.ID.EQ(authorID),
+		qm.Load(dbmodels.AuthorRels.Articles),
+	).OneG(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Author: \n\tID:%d \n\tName:%s \n\tEmail:%s\n", author.ID, author.Name, author.Email)
+
+	for _, a := range author.R.Articles {
+		fmt.Printf("Article: \n\tID:%d \n\tTitle:%s \n\tBody:%s \n\tCreatedAt:%v\n", a.ID, a.Title, a.Body.String, a.CreatedAt.Time)
+	}
+}
+
+func selectAuthorWithArticleJoin(ctx context.Context, authorID int) {
+	pairs, err := dbmodels.FindPairs[dbmodels.Author, dbmodels.Article](ctx, boil.GetContextDB(),
+		qm.InnerJoin("article on article.author_id = author.id"),
+		dbmodels.AuthorWhere.ID.EQ(authorID),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, pair := range pairs {
+		author, a := pair.A, pair.B
+
+		fmt.Printf("Author: \n\tID:%d \n\tName:%s \n\tEmail:%s\n", author.ID, author.Name, author.Email)
+		fmt.Printf("Article: \n\tID:%d \n\tTitle:%s \n\tBody:%s \n\tCreatedAt:%v\n", a.ID, a.Title, a.Body.String, a.CreatedAt.Time)
+	}
+}
@@ -0,0 +1,298 @@
+// Package diff renders the differences between two texts, either line by
+// line as a unified diff or word by word inline, like git diff
+// --word-diff=plain does.
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Context is the number of unchanged lines a unified diff shows around
+// every change.
+const Context = 3
+
+// Op is what an edit does with a token.
+type Op int
+
+const (
+	// Equal keeps a token that is in both texts.
+	Equal Op = iota
+	// Delete removes a token of the old text.
+	Delete
+	// Insert adds a token of the new text.
+	Insert
+)
+
+// Edit is one step of turning the old text into the new one.
+type Edit struct {
+	Op   Op
+	Text string
+}
+
+// Unified returns the unified diff turning a into b, with the names of the
+// two texts in the --- and +++ header lines. It is empty if the texts are
+// the same.
+func Unified(fromName, toName, a, b string) string {
+	edits := compute(lines(a), lines(b))
+
+	// aPos and bPos hold the line of a and b each edit starts at.
+	aPos := make([]int, len(edits)+1)
+	bPos := make([]int, len(edits)+1)
+	var changes []int
+	for i, e := range edits {
+		aPos[i+1], bPos[i+1] = aPos[i], bPos[i]
+		if e.Op != Insert {
+			aPos[i+1]++
+		}
+		if e.Op != Delete {
+			bPos[i+1]++
+		}
+		if e.Op != Equal {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+
+	for i := 0; i < len(changes); {
+		// A hunk runs from Context lines before a change to Context lines
+		// after the last change that is at most 2*Context lines away
+		// from the previous one.
+		start, end := changes[i]-Context, changes[i]+1+Context
+		for i++; i < len(changes) && changes[i]-Context <= end; i++ {
+			end = changes[i] + 1 + Context
+		}
+		if start < 0 {
+			start = 0
+		}
+		if end > len(edits) {
+			end = len(edits)
+		}
+
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(aPos[start], aPos[end]), hunkRange(bPos[start], bPos[end]))
+		for _, e := range edits[start:end] {
+			out.WriteString([]string{" ", "-", "+"}[e.Op])
+			out.WriteString(e.Text)
+			out.WriteByte('\n')
+		}
+	}
+
+	return out.String()
+}
+
+// hunkRange formats the lines from start up to end of a hunk header, which
+// counts lines from 1 but names the line before an empty range.
+func hunkRange(start, end int) string {
+	if start == end {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, end-start)
+}
+
+// Words returns b with the words of a that were removed marked as [-word-]
+// and the words that were added marked as {+word+}. Whitespace is kept as
+// it is in b, or in a for removed text.
+func Words(a, b string) string {
+	edits := compute(words(a), words(b))
+
+	var out strings.Builder
+	for i := 0; i < len(edits); {
+		op := edits[i].Op
+		var run strings.Builder
+		for ; i < len(edits) && edits[i].Op == op; i++ {
+			run.WriteString(edits[i].Text)
+		}
+
+		switch op {
+		case Delete:
+			fmt.Fprintf(&out, "[-%s-]", run.String())
+		case Insert:
+			fmt.Fprintf(&out, "{+%s+}", run.String())
+		default:
+			out.WriteString(run.String())
+		}
+	}
+
+	return out.String()
+}
+
+// lines splits s into lines without their line breaks.
+func lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// words splits s into runs of whitespace and of other characters.
+func words(s string) []string {
+	var tokens []string
+	start := 0
+	for i, r := range s {
+		first, _ := utf8.DecodeRuneInString(s[start:])
+		if i > start && unicode.IsSpace(r) != unicode.IsSpace(first) {
+			tokens = append(tokens, s[start:i])
+			start = i
+		}
+	}
+	if start < len(s) {
+		tokens = append(tokens, s[start:])
+	}
+	return tokens
+}
+
+// compute returns the shortest edit script turning a into b, using the
+// linear space variant of the algorithm of Myers' "An O(ND) Difference
+// Algorithm and Its Variations". Within every change, the deletions come
+// before the insertions.
+func compute(a, b []string) []Edit {
+	edits := script(nil, a, b)
+
+	for i := 0; i < len(edits); {
+		if edits[i].Op == Equal {
+			i++
+			continue
+		}
+		j := i
+		for j < len(edits) && edits[j].Op != Equal {
+			j++
+		}
+		change := edits[i:j]
+		sort.SliceStable(change, func(x, y int) bool { return change[x].Op == Delete && change[y].Op == Insert })
+		i = j
+	}
+
+	return edits
+}
+
+// script appends the edits turning a into b to edits. It splits both
+// texts where a shortest path through the middle of the edit graph
+// crosses, see middle, and recurses into the halves, which keeps the
+// memory linear in the length of the texts.
+func script(edits []Edit, a, b []string) []Edit {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		edits = append(edits, Edit{Op: Equal, Text: a[prefix]})
+		prefix++
+	}
+	a, b = a[prefix:], b[prefix:]
+
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	common := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	if x, y, ok := middle(a, b); ok {
+		edits = script(edits, a[:x], b[:y])
+		edits = script(edits, a[x:], b[y:])
+	} else {
+		for _, t := range a {
+			edits = append(edits, Edit{Op: Delete, Text: t})
+		}
+		for _, t := range b {
+			edits = append(edits, Edit{Op: Insert, Text: t})
+		}
+	}
+
+	for _, t := range common {
+		edits = append(edits, Edit{Op: Equal, Text: t})
+	}
+	return edits
+}
+
+// middle searches a shortest edit script from both ends of a and b at
+// once and returns the point x, y where the two searches meet, which lies
+// on a shortest path. ok is false if a and b have no token in common, or
+// either is empty.
+func middle(a, b []string) (x, y int, ok bool) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return 0, 0, false
+	}
+
+	// forward[k+off] is the furthest x reached from the start on diagonal
+	// k = x-y, backward[k+off] the furthest reached from the end on
+	// diagonal k of the reversed texts, -1 where none was yet.
+	maxD := (n + m + 1) / 2
+	off := maxD + 1
+	forward := make([]int, 2*off+1)
+	backward := make([]int, 2*off+1)
+	for i := range forward {
+		forward[i], backward[i] = -1, -1
+	}
+	forward[off+1], backward[off+1] = 0, 0
+
+	// The searches meet in the forward one if the total number of edits
+	// is odd, in the backward one if it is even.
+	delta := n - m
+	odd := delta%2 != 0
+
+	// Diagonals whose path left the edit graph are skipped.
+	var fStart, fEnd, bStart, bEnd int
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && forward[off+k-1] < forward[off+k+1]) {
+				x = forward[off+k+1]
+			} else {
+				x = forward[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			forward[off+k] = x
+
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case odd:
+				if bk := off + delta - k; bk >= 0 && bk < len(backward) && backward[bk] != -1 && x >= n-backward[bk] {
+					return x, y, true
+				}
+			}
+		}
+
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && backward[off+k-1] < backward[off+k+1]) {
+				x = backward[off+k+1]
+			} else {
+				x = backward[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x, y = x+1, y+1
+			}
+			backward[off+k] = x
+
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !odd:
+				if fk := off + delta - k; fk >= 0 && fk < len(forward) && forward[fk] != -1 {
+					fx := forward[fk]
+					if fx >= n-x {
+						return fx, fx - (fk - off), true
+					}
+				}
+			}
+		}
+	}
+
+	return 0, 0, false
+}
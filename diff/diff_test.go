@@ -0,0 +1,169 @@
+package diff
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// lcsLength returns the length of the longest common subsequence of a and
+// b, the number of tokens a shortest edit script keeps.
+func lcsLength(a, b []string) int {
+	l := make([][]int, len(a)+1)
+	for i := range l {
+		l[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				l[i][j] = l[i+1][j+1] + 1
+			case l[i+1][j] > l[i][j+1]:
+				l[i][j] = l[i+1][j]
+			default:
+				l[i][j] = l[i][j+1]
+			}
+		}
+	}
+	return l[0][0]
+}
+
+func TestCompute(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tokens := func() []string {
+		s := make([]string, r.Intn(20))
+		for i := range s {
+			s[i] = string(rune('a' + r.Intn(4)))
+		}
+		return s
+	}
+
+	for i := 0; i < 5000; i++ {
+		a, b := tokens(), tokens()
+		edits := compute(a, b)
+
+		var from, to []string
+		changes := 0
+		for j, e := range edits {
+			if e.Op != Insert {
+				from = append(from, e.Text)
+			}
+			if e.Op != Delete {
+				to = append(to, e.Text)
+			}
+			if e.Op != Equal {
+				changes++
+			}
+			if j > 0 && e.Op == Delete && edits[j-1].Op == Insert {
+				t.Fatalf("compute(%q, %q) = %v, inserts before deletes", a, b, edits)
+			}
+		}
+
+		if !reflect.DeepEqual(from, a) && (len(from) != 0 || len(a) != 0) {
+			t.Fatalf("compute(%q, %q) = %v, doesn't start from a", a, b, edits)
+		}
+		if !reflect.DeepEqual(to, b) && (len(to) != 0 || len(b) != 0) {
+			t.Fatalf("compute(%q, %q) = %v, doesn't end at b", a, b, edits)
+		}
+		if want := len(a) + len(b) - 2*lcsLength(a, b); changes != want {
+			t.Fatalf("compute(%q, %q) = %v, %d changes, want %d", a, b, edits, changes, want)
+		}
+	}
+}
+
+// numbered returns the lines 1 to 20, with the lines in replace replaced,
+// or dropped if replaced by "".
+func numbered(replace map[int]string) string {
+	var s strings.Builder
+	for i := 1; i <= 20; i++ {
+		line, ok := replace[i]
+		if !ok {
+			line = strconv.Itoa(i)
+		}
+		if line != "" {
+			s.WriteString(line + "\n")
+		}
+	}
+	return s.String()
+}
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"same", numbered(nil), numbered(nil), ""},
+		{
+			"change",
+			numbered(nil), numbered(map[int]string{10: "ten"}),
+			"--- a\n+++ b\n@@ -7,7 +7,7 @@\n 7\n 8\n 9\n-10\n+ten\n 11\n 12\n 13\n",
+		},
+		{
+			"merged hunks",
+			numbered(nil), numbered(map[int]string{5: "five", 12: "twelve"}),
+			"--- a\n+++ b\n@@ -2,14 +2,14 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n 9\n 10\n 11\n-12\n+twelve\n 13\n 14\n 15\n",
+		},
+		{
+			"separate hunks",
+			numbered(nil), numbered(map[int]string{5: "five", 13: "thirteen"}),
+			"--- a\n+++ b\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n@@ -10,7 +10,7 @@\n 10\n 11\n 12\n-13\n+thirteen\n 14\n 15\n 16\n",
+		},
+		{
+			"delete first line",
+			numbered(nil), numbered(map[int]string{1: ""}),
+			"--- a\n+++ b\n@@ -1,4 +1,3 @@\n-1\n 2\n 3\n 4\n",
+		},
+		{
+			"insert first line",
+			numbered(nil), "0\n" + numbered(nil),
+			"--- a\n+++ b\n@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n",
+		},
+		{
+			"append line",
+			numbered(nil), numbered(nil) + "21\n",
+			"--- a\n+++ b\n@@ -18,3 +18,4 @@\n 18\n 19\n 20\n+21\n",
+		},
+		{"from empty", "", "x\n", "--- a\n+++ b\n@@ -0,0 +1,1 @@\n+x\n"},
+		{"to empty", "x\n", "", "--- a\n+++ b\n@@ -1,1 +0,0 @@\n-x\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified("a", "b", tt.a, tt.b); got != tt.want {
+				t.Errorf("Unified() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"the quick brown fox", "the quick brown fox", "the quick brown fox"},
+		{"the quick brown fox", "the slow brown dog", "the [-quick-]{+slow+} brown [-fox-]{+dog+}"},
+		{"hello world", "hello brave new world", "hello {+brave new +}world"},
+		{"naïve\u00a0café", "naïve\u00a0bistro", "naïve\u00a0[-café-]{+bistro+}"},
+		{"naïve café", "naïve\u00a0bistro", "naïve[- café-]{+\u00a0bistro+}"},
+		{"naïve\u3000café", "naïve\u3000bistro", "naïve\u3000[-café-]{+bistro+}"},
+	}
+
+	for _, tt := range tests {
+		if got := Words(tt.a, tt.b); got != tt.want {
+			t.Errorf("Words(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestWordsTokens(t *testing.T) {
+	// U+00A0 and U+3000 are spaces of two and three bytes.
+	got := words("a\u00a0b\u3000\u3000c é  d")
+	want := []string{"a", "\u00a0", "b", "\u3000\u3000", "c", " ", "é", "  ", "d"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("words() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db/filter"
+	"github.com/gurleensethi/go-sql-boiler-example/repository"
+	"github.com/spf13/pflag"
+)
+
+// filterFlags holds the flags filtering and sorting the list commands, in
+// the syntax of the REST API.
+type filterFlags struct {
+	where   []string
+	sort    string
+	deleted string
+}
+
+func (f *filterFlags) register(flags *pflag.FlagSet) {
+	f.registerQuery(flags)
+	flags.StringVar(&f.deleted, "deleted", "exclude", "whether to list deleted rows (exclude, include or only)")
+}
+
+// registerQuery registers the flags parsed by query only, for tables
+// without soft deletes.
+func (f *filterFlags) registerQuery(flags *pflag.FlagSet) {
+	flags.StringArrayVar(&f.where, "where", nil, `filter as column=[operator:]value, e.g. "title=like:Hello*", can be repeated`)
+	flags.StringVar(&f.sort, "sort", "", "column to sort by, -column for descending order")
+}
+
+// query parses the flags against schema.
+func (f *filterFlags) query(schema *filter.Schema) (filter.Query, error) {
+	values := url.Values{}
+	for _, where := range f.where {
+		column, value, ok := strings.Cut(where, "=")
+		if !ok || column == filter.SortParam {
+			return filter.Query{}, fmt.Errorf("invalid --where %q, expected column=[operator:]value", where)
+		}
+		values.Add(column, value)
+	}
+	if f.sort != "" {
+		values.Set(filter.SortParam, f.sort)
+	}
+
+	return schema.Parse(values)
+}
+
+// deletedScope parses the --deleted flag.
+func (f *filterFlags) deletedScope() (repository.Deleted, error) {
+	return repository.ParseDeleted(f.deleted)
+}
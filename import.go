@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// importFlags holds the flags shared by the import commands.
+type importFlags struct {
+	noHooks  bool
+	progress int64
+}
+
+func (f *importFlags) register(flags *pflag.FlagSet) {
+	flags.BoolVar(&f.noHooks, "no-hooks", false, "don't run the insert hooks")
+	flags.Int64Var(&f.progress, "progress", 10000, "report progress every this many rows, 0 disables it")
+}
+
+// options returns the copy options selected by the flags. Progress is
+// reported on stderr so it doesn't mix with the command output.
+func (f *importFlags) options(cmd *cobra.Command, what string) []dbmodels.CopyOption {
+	var opts []dbmodels.CopyOption
+	if f.noHooks {
+		opts = append(opts, dbmodels.CopySkipHooks())
+	}
+	if f.progress > 0 {
+		opts = append(opts, dbmodels.CopyProgress(f.progress, func(rows int64) {
+			fmt.Fprintf(cmd.ErrOrStderr(), "copied %d %s\n", rows, what)
+		}))
+	}
+	return opts
+}
+
+// openInput opens the file to import, "-" is stdin.
+func openInput(cmd *cobra.Command, path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(cmd.InOrStdin()), nil
+	}
+	return os.Open(path)
+}
+
+// jsonDecoder returns a decoder for a stream of JSON objects, such as JSON
+// lines, that rejects unknown fields.
+func jsonDecoder(r io.Reader) *json.Decoder {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	return dec
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db/migrate"
+	"github.com/gurleensethi/go-sql-boiler-example/db/migrations"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func newMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply and revert schema migrations",
+		Annotations: map[string]string{
+			annotationNoSchemaCheck: "true",
+		},
+	}
+
+	cmd.AddCommand(
+		newMigrateUpCmd(),
+		newMigrateDownCmd(),
+		newMigrateStatusCmd(),
+		newMigrateCreateCmd(),
+	)
+
+	return cmd
+}
+
+// migrator returns a Migrator applying the embedded migrations on the
+// connection opened by the root command.
+func migrator() (*migrate.Migrator, error) {
+	return migrate.New(boil.GetDB().(*sql.DB), migrations.FS)
+}
+
+func newMigrateUpCmd() *cobra.Command {
+	var steps int
+
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Apply pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := migrator()
+			if err != nil {
+				return err
+			}
+
+			ran, err := m.Up(cmd.Context(), steps)
+			for _, migration := range ran {
+				fmt.Fprintf(cmd.OutOrStdout(), "applied %04d_%s\n", migration.Version, migration.Name)
+			}
+			if err == nil && len(ran) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no pending migrations")
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().IntVar(&steps, "steps", 0, "number of migrations to apply, 0 applies all")
+
+	return cmd
+}
+
+func newMigrateDownCmd() *cobra.Command {
+	var steps int
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Revert applied migrations, the latest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := migrator()
+			if err != nil {
+				return err
+			}
+
+			if all {
+				steps = 0
+			} else if steps < 1 {
+				return fmt.Errorf("--steps must be at least 1, use --all to revert everything")
+			}
+
+			ran, err := m.Down(cmd.Context(), steps)
+			for _, migration := range ran {
+				fmt.Fprintf(cmd.OutOrStdout(), "reverted %04d_%s\n", migration.Version, migration.Name)
+			}
+			if err == nil && len(ran) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no applied migrations")
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().IntVar(&steps, "steps", 1, "number of migrations to revert")
+	cmd.Flags().BoolVar(&all, "all", false, "revert all migrations")
+
+	return cmd
+}
+
+func newMigrateStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "List migrations and whether they were applied",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := migrator()
+			if err != nil {
+				return err
+			}
+
+			statuses, err := m.Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			t := table{headers: []string{"VERSION", "NAME", "STATE", "APPLIED AT"}}
+			for _, s := range statuses {
+				t.rows = append(t.rows, []string{
+					strconv.Itoa(s.Version),
+					s.Name,
+					s.State,
+					formatNullTime(null.TimeFromPtr(s.AppliedAt)),
+				})
+			}
+
+			return printResult(cmd, statuses, t)
+		},
+	}
+}
+
+func newMigrateCreateCmd() *cobra.Command {
+	var dir string
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create empty up and down files for a new migration",
+		Args:  cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			annotationNoDatabase: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			paths, err := migrate.Create(dir, args[0])
+			for _, path := range paths {
+				fmt.Fprintf(cmd.OutOrStdout(), "created %s\n", path)
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", "db/migrations", "directory holding the migrations")
+
+	return cmd
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// table is the tabular form of a command result, used when printing with
+// the "table" output format.
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+// printResult writes v to the command's output in the format selected with
+// the --output flag. v is encoded as JSON with its timestamps in
+// outputLocation, t is used for tables.
+func printResult(cmd *cobra.Command, v interface{}, t table) error {
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case formatJSON:
+		return printJSON(cmd.OutOrStdout(), dbmodels.InLocation(v, outputLocation))
+	case formatTable:
+		return printTable(cmd.OutOrStdout(), t)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+func printJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func printTable(w io.Writer, t table) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.headers, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func authorTable(authors ...*dbmodels.Author) table {
+	t := table{headers: []string{"ID", "NAME", "EMAIL", "UPDATED AT", "DELETED AT"}}
+	for _, a := range authors {
+		t.rows = append(t.rows, []string{strconv.Itoa(a.ID), a.Name, a.Email, formatTime(a.UpdatedAt), formatNullTime(a.DeletedAt)})
+	}
+	return t
+}
+
+func articleTable(articles ...*dbmodels.Article) table {
+	t := table{headers: []string{"ID", "TITLE", "AUTHOR ID", "STATUS", "PUBLISHED AT", "CREATED AT", "UPDATED AT", "DELETED AT", "BODY"}}
+	for _, a := range articles {
+		t.rows = append(t.rows, []string{
+			strconv.Itoa(a.ID),
+			a.Title,
+			strconv.Itoa(a.AuthorID),
+			a.Status.String(),
+			formatNullTime(a.PublishedAt),
+			formatNullTime(a.CreatedAt),
+			formatTime(a.UpdatedAt),
+			formatNullTime(a.DeletedAt),
+			truncate(a.Body.String, 40),
+		})
+	}
+	return t
+}
+
+func tagTable(tags ...*dbmodels.Tag) table {
+	t := table{headers: []string{"ID", "NAME"}}
+	for _, tag := range tags {
+		t.rows = append(t.rows, []string{strconv.Itoa(tag.ID), tag.Name})
+	}
+	return t
+}
+
+func creditTable(credits ...*dbmodels.ArticleAuthor) table {
+	t := table{headers: []string{"AUTHOR ID", "NAME", "ROLE"}}
+	for _, c := range credits {
+		// Deleted authors aren't loaded.
+		name := ""
+		if author := c.R.GetAuthor(); author != nil {
+			name = author.Name
+		}
+		t.rows = append(t.rows, []string{strconv.Itoa(c.AuthorID), name, c.Role.String()})
+	}
+	return t
+}
+
+func revisionTable(revisions ...*dbmodels.ArticleRevision) table {
+	t := table{headers: []string{"VERSION", "TITLE", "BODY", "CREATED AT"}}
+	for _, r := range revisions {
+		t.rows = append(t.rows, []string{
+			strconv.Itoa(r.Version),
+			truncate(r.Title, 40),
+			truncate(r.Body.String, 40),
+			formatTime(r.CreatedAt),
+		})
+	}
+	return t
+}
+
+func auditTable(entries ...*dbmodels.AuditLog) (table, error) {
+	t := table{headers: []string{"ID", "AT", "ACTOR", "OPERATION", "TABLE", "ROW ID", "CHANGED"}}
+	for _, e := range entries {
+		columns, err := e.ChangedColumns()
+		if err != nil {
+			return table{}, err
+		}
+		t.rows = append(t.rows, []string{
+			strconv.FormatInt(e.ID, 10),
+			formatTime(e.CreatedAt),
+			e.Actor.String,
+			e.Operation.String(),
+			e.TableName,
+			strconv.Itoa(e.RowID),
+			truncate(strings.Join(columns, ", "), 60),
+		})
+	}
+	return t, nil
+}
+
+// formatTime formats t in outputLocation.
+func formatTime(t time.Time) string {
+	return t.In(outputLocation).Format(time.RFC3339)
+}
+
+func formatNullTime(t null.Time) string {
+	if !t.Valid {
+		return ""
+	}
+	return formatTime(t.Time)
+}
+
+// truncate shortens s to at most n runes so long values don't break the
+// table layout. Newlines are flattened for the same reason.
+func truncate(s string, n int) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-3]) + "..."
+}
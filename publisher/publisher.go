@@ -0,0 +1,220 @@
+// Package publisher publishes scheduled articles once their publish time
+// has come.
+//
+// A Worker polls for due articles with SELECT ... FOR UPDATE SKIP LOCKED
+// and publishes them in the same transaction, so any number of workers can
+// run against the same database: an article locked by one worker is
+// skipped by the others, and an article published meanwhile no longer
+// matches when it is locked. Articles are published with
+// dbmodels.Article.Transition, which runs the update hooks.
+package publisher
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Clock tells a Worker the current time, which decides which articles are
+// due and when they are published, and times the waits between polls.
+// Tests pass a clock they control.
+type Clock interface {
+	Now() time.Time
+	// After returns a channel receiving the time once d has passed.
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the Clock of the system.
+type SystemClock struct{}
+
+// Now returns time.Now.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After returns time.After(d).
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Default settings of a Worker.
+const (
+	DefaultInterval  = 30 * time.Second
+	DefaultBatchSize = 100
+)
+
+// Worker publishes due articles, see the package documentation.
+type Worker struct {
+	exec      boil.ContextExecutor
+	clock     Clock
+	interval  time.Duration
+	batchSize int
+
+	mu sync.Mutex
+	// failed holds the articles that failed to publish and when to try
+	// them again.
+	failed map[int]time.Time
+}
+
+// Option configures a Worker.
+type Option func(*Worker)
+
+// UseClock sets the clock of the worker, SystemClock by default.
+func UseClock(c Clock) Option {
+	return func(w *Worker) { w.clock = c }
+}
+
+// Interval sets how long Run waits between polls when there are no more
+// due articles, DefaultInterval by default. d must be positive.
+func Interval(d time.Duration) Option {
+	return func(w *Worker) { w.interval = d }
+}
+
+// BatchSize sets how many articles are published per transaction at most,
+// DefaultBatchSize by default. n must be positive.
+func BatchSize(n int) Option {
+	return func(w *Worker) { w.batchSize = n }
+}
+
+// New returns a Worker publishing articles stored in exec, which must be
+// able to begin transactions, such as a *sql.DB.
+func New(exec boil.ContextExecutor, opts ...Option) *Worker {
+	w := &Worker{
+		exec:      exec,
+		clock:     SystemClock{},
+		interval:  DefaultInterval,
+		batchSize: DefaultBatchSize,
+		failed:    map[int]time.Time{},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Run publishes due articles until ctx is done. It polls again right away
+// after a full batch and waits for the interval otherwise. Errors are
+// logged and retried at the next poll.
+func (w *Worker) Run(ctx context.Context) error {
+	for {
+		published, full, err := w.publishBatch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("publisher: %v", err)
+		}
+		for _, article := range published {
+			log.Printf("publisher: published article %d", article.ID)
+		}
+
+		if err != nil || !full {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-w.clock.After(w.interval):
+			}
+		}
+	}
+}
+
+// PublishAllDue publishes batches of due articles like PublishDue until
+// none are left and returns the published articles.
+func (w *Worker) PublishAllDue(ctx context.Context) (dbmodels.ArticleSlice, error) {
+	var all dbmodels.ArticleSlice
+	for {
+		published, full, err := w.publishBatch(ctx)
+		all = append(all, published...)
+		if err != nil || !full {
+			return all, err
+		}
+	}
+}
+
+// PublishDue publishes up to a batch of the articles due at the time of
+// the clock in one transaction and returns them. An article that fails to
+// publish, e.g. because an update hook fails, is logged and left
+// scheduled; the others are published anyway. The worker skips it for the
+// interval, so it doesn't take up the batches of the articles due after
+// it.
+func (w *Worker) PublishDue(ctx context.Context) (dbmodels.ArticleSlice, error) {
+	published, _, err := w.publishBatch(ctx)
+	return published, err
+}
+
+// publishBatch is PublishDue, also reporting whether the batch was full,
+// i.e. whether more articles may be due.
+func (w *Worker) publishBatch(ctx context.Context) (published dbmodels.ArticleSlice, full bool, err error) {
+	err = db.WithTx(ctx, w.exec, func(tx boil.ContextExecutor) error {
+		published = nil
+		now := w.clock.Now().In(boil.GetLocation())
+
+		mods := []qm.QueryMod{
+			qm.Limit(w.batchSize),
+			qm.For("UPDATE SKIP LOCKED"),
+		}
+		if skipped := w.skipped(now); len(skipped) > 0 {
+			mods = append(mods, dbmodels.ArticleWhere.ID.NIN(skipped))
+		}
+
+		due, err := dbmodels.DueArticles(now, mods...).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+		full = len(due) == w.batchSize
+
+		for _, article := range due {
+			// A savepoint per article keeps a failed one from rolling back
+			// the others.
+			err := db.WithTx(ctx, tx, func(tx boil.ContextExecutor) error {
+				return article.Transition(ctx, tx, dbmodels.ArticleStatusPublished, now)
+			})
+			if err != nil {
+				log.Printf("publisher: unable to publish article %d: %v", article.ID, err)
+				w.skip(article.ID, now.Add(w.interval))
+				continue
+			}
+			published = append(published, article)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return published, full, nil
+}
+
+// skip makes the worker skip the article with the given id until retry.
+func (w *Worker) skip(id int, retry time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.failed[id] = retry
+}
+
+// skipped returns the ids of the articles to skip at now, forgetting those
+// to try again.
+func (w *Worker) skipped(now time.Time) []int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ids := make([]int, 0, len(w.failed))
+	for id, retry := range w.failed {
+		if !retry.After(now) {
+			delete(w.failed, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -0,0 +1,236 @@
+package publisher
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/volatiletech/null/v8"
+)
+
+var now = time.Date(2030, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+// fakeClock is a Clock standing still at now until a test moves it. Its
+// After reports the durations asked for on waits and only fires when the
+// test sends on fire.
+type fakeClock struct {
+	now   time.Time
+	waits chan time.Duration
+	fire  chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: now, waits: make(chan time.Duration, 1), fire: make(chan time.Time)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits <- d
+	return c.fire
+}
+
+const dueQuery = `SELECT "article"\.\* FROM "article" WHERE .*"article"\."status" = \$1.*"article"\."published_at" <= \$2.* LIMIT \d+ FOR UPDATE SKIP LOCKED`
+
+// dueRows returns the rows of the due scheduled articles with the given
+// ids.
+func dueRows(ids ...int) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "title", "author_id", "status", "published_at", "version"})
+	for _, id := range ids {
+		rows.AddRow(id, "Scheduled", 1, dbmodels.ArticleStatusScheduled, now.Add(-time.Minute), 1)
+	}
+	return rows
+}
+
+// expectPublish expects the savepoint publishing a due article with the
+// given id, failing with err if it isn't nil, and the audit log entry
+// holding the article as it was before.
+func expectPublish(mock sqlmock.Sqlmock, id int, err error) {
+	mock.ExpectExec("SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+	before := fmt.Sprintf(`{"id": %d, "status": "scheduled"}`, id)
+	mock.ExpectQuery(`SELECT \(SELECT to_jsonb\("row"\) FROM \(SELECT .* FROM "article" WHERE "id" = \$1 FOR UPDATE\) AS "row"\)`).
+		WithArgs(id).WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow(before))
+	update := mock.ExpectExec(`UPDATE "article" SET "status"=\$1,"published_at"=\$2,"updated_at"=\$3 WHERE "id"=\$4 AND "version"=\$5`)
+	if err != nil {
+		update.WillReturnError(err)
+		mock.ExpectExec("ROLLBACK TO SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+		return
+	}
+	update.WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "audit_log"`).WithArgs(sqlmock.AnyArg(), dbmodels.AuditOperationUpdate, "article", id, null.JSONFrom([]byte(before))).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "article_revision"`).WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func newMock(t *testing.T) (*sql.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+	return conn, mock
+}
+
+func TestPublishDue(t *testing.T) {
+	conn, mock := newMock(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(dueQuery).WithArgs(dbmodels.ArticleStatusScheduled, now).WillReturnRows(dueRows(1, 2, 3))
+	expectPublish(mock, 1, nil)
+	expectPublish(mock, 2, errors.New("hook failed"))
+	expectPublish(mock, 3, nil)
+	mock.ExpectCommit()
+
+	published, err := New(conn, UseClock(newFakeClock())).PublishDue(context.Background())
+	if err != nil {
+		t.Fatalf("PublishDue() = %v", err)
+	}
+
+	if len(published) != 2 || published[0].ID != 1 || published[1].ID != 3 {
+		t.Fatalf("PublishDue() published %v, want articles 1 and 3", published)
+	}
+	for _, article := range published {
+		if article.Status != dbmodels.ArticleStatusPublished || article.Version != 2 {
+			t.Errorf("article %d is %s at version %d, want published at version 2", article.ID, article.Status, article.Version)
+		}
+	}
+}
+
+func TestPublishDueTwoWorkers(t *testing.T) {
+	// Postgres hands a due article locked by one worker's transaction to
+	// none of the others, whose SKIP LOCKED select doesn't return it.
+	connA, mockA := newMock(t)
+	connB, mockB := newMock(t)
+
+	mockA.ExpectBegin()
+	mockA.ExpectQuery(dueQuery).WillReturnRows(dueRows(1))
+	expectPublish(mockA, 1, nil)
+	mockA.ExpectCommit()
+
+	mockB.ExpectBegin()
+	mockB.ExpectQuery(dueQuery).WillReturnRows(dueRows())
+	mockB.ExpectCommit()
+
+	results := make(chan dbmodels.ArticleSlice, 2)
+	for _, conn := range []*sql.DB{connA, connB} {
+		w := New(conn, UseClock(newFakeClock()))
+		go func() {
+			published, err := w.PublishDue(context.Background())
+			if err != nil {
+				t.Errorf("PublishDue() = %v", err)
+			}
+			results <- published
+		}()
+	}
+
+	total := 0
+	for i := 0; i < 2; i++ {
+		total += len(<-results)
+	}
+	if total != 1 {
+		t.Errorf("the workers published %d articles, want 1", total)
+	}
+}
+
+func TestRun(t *testing.T) {
+	conn, mock := newMock(t)
+	clock := newFakeClock()
+
+	// A full batch is followed by another poll right away, one that isn't
+	// by a wait for the interval.
+	mock.ExpectBegin()
+	mock.ExpectQuery(dueQuery).WillReturnRows(dueRows(1, 2))
+	expectPublish(mock, 1, nil)
+	expectPublish(mock, 2, nil)
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(dueQuery).WillReturnRows(dueRows(3))
+	expectPublish(mock, 3, nil)
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(dueQuery).WillReturnRows(dueRows())
+	mock.ExpectCommit()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- New(conn, UseClock(clock), BatchSize(2), Interval(time.Minute)).Run(ctx)
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case d := <-clock.waits:
+			if d != time.Minute {
+				t.Errorf("Run() waited %s, want the interval", d)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Run() didn't wait for the interval")
+		}
+		if i == 0 {
+			clock.fire <- now
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Run() = %v", err)
+	}
+}
+
+func TestPublishAllDueSkipsFailed(t *testing.T) {
+	conn, mock := newMock(t)
+	clock := newFakeClock()
+	w := New(conn, UseClock(clock), BatchSize(2), Interval(time.Minute))
+
+	// Articles that failed to publish are left out of the following
+	// batches, so the articles due after them are published.
+	failed := errors.New("hook failed")
+	mock.ExpectBegin()
+	mock.ExpectQuery(dueQuery).WithArgs(dbmodels.ArticleStatusScheduled, now).WillReturnRows(dueRows(1, 2))
+	expectPublish(mock, 1, failed)
+	expectPublish(mock, 2, failed)
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "article"\.\* FROM "article" WHERE .*"article"\."id" NOT IN \(\$3,\$4\).* FOR UPDATE SKIP LOCKED`).WithArgs(dbmodels.ArticleStatusScheduled, now, 1, 2).WillReturnRows(dueRows(3))
+	expectPublish(mock, 3, nil)
+	mock.ExpectCommit()
+
+	published, err := w.PublishAllDue(context.Background())
+	if err != nil {
+		t.Fatalf("PublishAllDue() = %v", err)
+	}
+	if len(published) != 1 || published[0].ID != 3 {
+		t.Fatalf("PublishAllDue() published %v, want article 3", published)
+	}
+
+	// They are tried again after the interval.
+	clock.now = now.Add(time.Minute)
+	mock.ExpectBegin()
+	mock.ExpectQuery(dueQuery).WithArgs(dbmodels.ArticleStatusScheduled, clock.now).WillReturnRows(dueRows(1, 2))
+	expectPublish(mock, 1, nil)
+	expectPublish(mock, 2, nil)
+	mock.ExpectCommit()
+
+	published, err = w.PublishDue(context.Background())
+	if err != nil {
+		t.Fatalf("PublishDue() = %v", err)
+	}
+	if len(published) != 2 {
+		t.Fatalf("PublishDue() published %v, want articles 1 and 2", published)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// newArticlesStatusCmds returns the commands moving an article along
+// dbmodels.ArticleTransitions.
+func newArticlesStatusCmds() []*cobra.Command {
+	return []*cobra.Command{
+		newArticlesStatusCmd("submit", "Submit a draft for review", dbmodels.ArticleStatusInReview),
+		newArticlesStatusCmd("schedule", "Schedule an article to be published at --at", dbmodels.ArticleStatusScheduled),
+		newArticlesStatusCmd("publish", "Publish an article now", dbmodels.ArticleStatusPublished),
+		newArticlesStatusCmd("unpublish", "Move an article back to draft", dbmodels.ArticleStatusDraft),
+		newArticlesStatusCmd("archive", "Archive an article", dbmodels.ArticleStatusArchived),
+	}
+}
+
+func newArticlesStatusCmd(name, short string, status dbmodels.ArticleStatus) *cobra.Command {
+	var (
+		at      string
+		version int
+	)
+
+	cmd := &cobra.Command{
+		Use:   name + " <id>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			publishAt := time.Now().In(boil.GetLocation())
+			if status == dbmodels.ArticleStatusScheduled {
+				if publishAt, err = time.Parse(time.RFC3339, at); err != nil {
+					return fmt.Errorf("invalid --at %q: %w", at, err)
+				}
+			}
+
+			article, err := repos().Articles.Find(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("expect-version") {
+				article.Version = version
+			}
+
+			if err := repos().Articles.Transition(cmd.Context(), article, status, publishAt); err != nil {
+				return err
+			}
+
+			return printResult(cmd, article, articleTable(article))
+		},
+	}
+
+	if status == dbmodels.ArticleStatusScheduled {
+		cmd.Flags().StringVar(&at, "at", "", "time to publish the article at (RFC 3339)")
+		cmd.MarkFlagRequired("at")
+	}
+	cmd.Flags().IntVar(&version, "expect-version", 0, "only change the article if it is still at this version")
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	"github.com/gurleensethi/go-sql-boiler-example/repository"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// errDryRun rolls back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+func newPurgeCmd() *cobra.Command {
+	var (
+		olderThan time.Duration
+		dryRun    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "purge",
+		Short: "Remove deleted authors and articles for good",
+		Long: `Remove authors and articles that were deleted longer ago than --older-than
+for good, they can't be restored afterwards. Authors that still have
+articles are kept until their articles are purged too. The audit log
+keeps every purged row.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if olderThan < 0 {
+				return fmt.Errorf("invalid --older-than %s, must not be negative", olderThan)
+			}
+			cutoff := time.Now().Add(-olderThan)
+
+			var articles, authors int64
+			err := db.WithTx(cmd.Context(), boil.GetContextDB(), func(tx boil.ContextExecutor) error {
+				repos := repository.NewPostgres(tx, signer)
+
+				var err error
+				// Articles go first so their authors can be purged in the
+				// same run.
+				if articles, err = repos.Articles.Purge(cmd.Context(), cutoff); err != nil {
+					return err
+				}
+				if authors, err = repos.Authors.Purge(cmd.Context(), cutoff); err != nil {
+					return err
+				}
+
+				if dryRun {
+					return errDryRun
+				}
+				return nil
+			})
+			if err != nil && !errors.Is(err, errDryRun) {
+				return err
+			}
+
+			verb := "purged"
+			if dryRun {
+				verb = "would purge"
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s %d articles and %d authors deleted before %s\n", verb, articles, authors, cutoff.Format(time.RFC3339))
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&olderThan, "older-than", 30*24*time.Hour, "retention period of deleted rows")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report what would be purged and roll back")
+
+	return cmd
+}
@@ -0,0 +1,490 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/db/pagination"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
+)
+
+// NewMemory returns repositories keeping all rows in memory. Authors and
+// articles share one store so the fk_author_id constraint can be enforced.
+// A nil signer signs cursors with a random key.
+func NewMemory(signer *pagination.Signer) Repositories {
+	if signer == nil {
+		signer = pagination.NewSigner(nil)
+	}
+
+	s := &memoryStore{
+		signer:   signer,
+		authors:  map[int]dbmodels.Author{},
+		articles: map[int]dbmodels.Article{},
+	}
+
+	return Repositories{
+		Authors:  memoryAuthors{s},
+		Articles: memoryArticles{s},
+	}
+}
+
+type memoryStore struct {
+	mu     sync.RWMutex
+	signer *pagination.Signer
+
+	authors    map[int]dbmodels.Author
+	articles   map[int]dbmodels.Article
+	authorSeq  int
+	articleSeq int
+}
+
+type memoryAuthors struct {
+	s *memoryStore
+}
+
+func (r memoryAuthors) Find(ctx context.Context, id int) (*dbmodels.Author, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	a, ok := r.s.authors[id]
+	if !ok || a.DeletedAt.Valid {
+		return nil, notFound(dbmodels.TableNames.Author)
+	}
+	return &a, nil
+}
+
+func (r memoryAuthors) FindWithArticles(ctx context.Context, id int) (*dbmodels.Author, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	a, ok := r.s.authors[id]
+	if !ok || a.DeletedAt.Valid {
+		return nil, notFound(dbmodels.TableNames.Author)
+	}
+
+	articles := r.s.listArticles(ArticleFilter{AuthorID: id})
+	a.R = a.R.NewStruct()
+	a.R.Articles = articles
+	for _, article := range articles {
+		article.R = article.R.NewStruct()
+		article.R.Author = &a
+	}
+
+	return &a, nil
+}
+
+func (r memoryAuthors) List(ctx context.Context, filter AuthorFilter) (dbmodels.AuthorSlice, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return r.s.listAuthors(filter), nil
+}
+
+func (r memoryAuthors) Page(ctx context.Context, filter AuthorFilter, p pagination.Params) (pagination.Page[*dbmodels.Author], error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	filter.Limit, filter.Offset = 0, 0
+	return pagination.Slice(r.s.signer, dbmodels.TableNames.Author, p, r.s.listAuthors(filter))
+}
+
+func (r memoryAuthors) Insert(ctx context.Context, author *dbmodels.Author) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	r.s.authorSeq++
+	author.ID = r.s.authorSeq
+	if author.UpdatedAt.IsZero() {
+		author.UpdatedAt = now().Time
+	}
+
+	r.s.authors[author.ID] = stripAuthor(*author)
+	return nil
+}
+
+func (r memoryAuthors) Update(ctx context.Context, author *dbmodels.Author, columns ...string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	stored, ok := r.s.authors[author.ID]
+	if !ok {
+		return notFound(dbmodels.TableNames.Author)
+	}
+
+	set, err := authorUpdateSet(columns)
+	if err != nil {
+		return err
+	}
+	for _, col := range set {
+		switch col {
+		case dbmodels.AuthorColumns.Email:
+			stored.Email = author.Email
+		case dbmodels.AuthorColumns.Name:
+			stored.Name = author.Name
+		}
+	}
+
+	// Like the generated Update, updated_at is always written.
+	author.UpdatedAt = now().Time
+	stored.UpdatedAt = author.UpdatedAt
+
+	r.s.authors[author.ID] = stored
+	return nil
+}
+
+func (r memoryAuthors) Delete(ctx context.Context, id int) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	a, ok := r.s.authors[id]
+	if !ok || a.DeletedAt.Valid {
+		return notFound(dbmodels.TableNames.Author)
+	}
+
+	for _, article := range r.s.articles {
+		if article.AuthorID == id && !article.DeletedAt.Valid {
+			return authorReferenced(id)
+		}
+	}
+
+	a.DeletedAt = now()
+	a.UpdatedAt = a.DeletedAt.Time
+	r.s.authors[id] = a
+	return nil
+}
+
+func (r memoryAuthors) Restore(ctx context.Context, id int) (*dbmodels.Author, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	a, ok := r.s.authors[id]
+	if !ok || !a.DeletedAt.Valid {
+		return nil, notFound(dbmodels.TableNames.Author)
+	}
+
+	a.DeletedAt = null.Time{}
+	a.UpdatedAt = now().Time
+	r.s.authors[id] = a
+	return &a, nil
+}
+
+func (r memoryAuthors) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	referenced := map[int]bool{}
+	for _, article := range r.s.articles {
+		referenced[article.AuthorID] = true
+	}
+
+	var n int64
+	for id, a := range r.s.authors {
+		if a.DeletedAt.Valid && a.DeletedAt.Time.Before(cutoff) && !referenced[id] {
+			delete(r.s.authors, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+type memoryArticles struct {
+	s *memoryStore
+}
+
+func (r memoryArticles) Find(ctx context.Context, id int) (*dbmodels.Article, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	a, ok := r.s.articles[id]
+	if !ok || a.DeletedAt.Valid {
+		return nil, notFound(dbmodels.TableNames.Article)
+	}
+	return &a, nil
+}
+
+func (r memoryArticles) List(ctx context.Context, filter ArticleFilter) (dbmodels.ArticleSlice, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	return r.s.listArticles(filter), nil
+}
+
+func (r memoryArticles) Page(ctx context.Context, filter ArticleFilter, p pagination.Params) (pagination.Page[*dbmodels.Article], error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	filter.Limit, filter.Offset = 0, 0
+	return pagination.Slice(r.s.signer, dbmodels.TableNames.Article, p, r.s.listArticles(filter))
+}
+
+func (r memoryArticles) Insert(ctx context.Context, article *dbmodels.Article) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	if err := r.s.checkAuthor(article.AuthorID); err != nil {
+		return err
+	}
+
+	r.s.articleSeq++
+	article.ID = r.s.articleSeq
+	if !article.CreatedAt.Valid {
+		article.CreatedAt = now()
+	}
+	if article.UpdatedAt.IsZero() {
+		article.UpdatedAt = now().Time
+	}
+	if article.Status == "" {
+		article.Status = dbmodels.ArticleStatusDraft
+	}
+
+	r.s.articles[article.ID] = stripArticle(*article)
+	return nil
+}
+
+func (r memoryArticles) Update(ctx context.Context, article *dbmodels.Article, columns ...string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	// Like the generated Update, only the row at the loaded version is
+	// written.
+	stored, ok := r.s.articles[article.ID]
+	if !ok || stored.Version != article.Version {
+		return &dbmodels.StaleObjectError{Table: dbmodels.TableNames.Article, Rows: 1}
+	}
+
+	set, err := articleUpdateSet(columns)
+	if err != nil {
+		return err
+	}
+	for _, col := range set {
+		switch col {
+		case dbmodels.ArticleColumns.Title:
+			stored.Title = article.Title
+		case dbmodels.ArticleColumns.Body:
+			stored.Body = article.Body
+		case dbmodels.ArticleColumns.CreatedAt:
+			stored.CreatedAt = article.CreatedAt
+		case dbmodels.ArticleColumns.AuthorID:
+			if err := r.s.checkAuthor(article.AuthorID); err != nil {
+				return err
+			}
+			stored.AuthorID = article.AuthorID
+		}
+	}
+
+	// bump_version increments the version on every update, and like the
+	// generated Update, updated_at is always written.
+	stored.Version++
+	article.Version = stored.Version
+	article.UpdatedAt = now().Time
+	stored.UpdatedAt = article.UpdatedAt
+
+	r.s.articles[article.ID] = stored
+	return nil
+}
+
+func (r memoryArticles) Transition(ctx context.Context, article *dbmodels.Article, status dbmodels.ArticleStatus, at time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	stored, ok := r.s.articles[article.ID]
+	if !ok || stored.Version != article.Version {
+		return &dbmodels.StaleObjectError{Table: dbmodels.TableNames.Article, Rows: 1}
+	}
+
+	// At the loaded version, article has the stored status. published_at
+	// is kept with the precision Postgres stores it with.
+	if err := article.ChangeStatus(status, at.Truncate(time.Microsecond)); err != nil {
+		return err
+	}
+
+	stored.Status = article.Status
+	stored.PublishedAt = article.PublishedAt
+	stored.Version++
+	article.Version = stored.Version
+	article.UpdatedAt = now().Time
+	stored.UpdatedAt = article.UpdatedAt
+
+	r.s.articles[article.ID] = stored
+	return nil
+}
+
+func (r memoryArticles) Delete(ctx context.Context, id int) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	a, ok := r.s.articles[id]
+	if !ok || a.DeletedAt.Valid {
+		return notFound(dbmodels.TableNames.Article)
+	}
+
+	a.DeletedAt = now()
+	a.Version++
+	a.UpdatedAt = a.DeletedAt.Time
+	r.s.articles[id] = a
+	return nil
+}
+
+func (r memoryArticles) DeleteAll(ctx context.Context, ids ...int) (int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	deletedAt := now()
+	var n int64
+	for _, id := range ids {
+		if a, ok := r.s.articles[id]; ok && !a.DeletedAt.Valid {
+			a.DeletedAt = deletedAt
+			a.Version++
+			a.UpdatedAt = deletedAt.Time
+			r.s.articles[id] = a
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r memoryArticles) Restore(ctx context.Context, id int) (*dbmodels.Article, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	a, ok := r.s.articles[id]
+	if !ok || !a.DeletedAt.Valid {
+		return nil, notFound(dbmodels.TableNames.Article)
+	}
+
+	a.DeletedAt = null.Time{}
+	a.Version++
+	a.UpdatedAt = now().Time
+	r.s.articles[id] = a
+	return &a, nil
+}
+
+func (r memoryArticles) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+
+	var n int64
+	for id, a := range r.s.articles {
+		if a.DeletedAt.Valid && a.DeletedAt.Time.Before(cutoff) {
+			delete(r.s.articles, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// listAuthors must be called with s.mu held.
+func (s *memoryStore) listAuthors(filter AuthorFilter) dbmodels.AuthorSlice {
+	var authors dbmodels.AuthorSlice
+	for _, a := range s.authors {
+		if filter.Name != "" && a.Name != filter.Name {
+			continue
+		}
+		if filter.Email != "" && a.Email != filter.Email {
+			continue
+		}
+		if !filter.Deleted.match(a.DeletedAt) {
+			continue
+		}
+		if !filter.Query.Match(&a) {
+			continue
+		}
+		a := a
+		authors = append(authors, &a)
+	}
+
+	sort.Slice(authors, func(i, j int) bool { return authors[i].ID < authors[j].ID })
+	if filter.Query.Sort != "" {
+		sort.SliceStable(authors, func(i, j int) bool { return filter.Query.Less(authors[i], authors[j]) })
+	}
+	start, end := window(len(authors), filter.Limit, filter.Offset)
+	return authors[start:end]
+}
+
+// listArticles must be called with s.mu held.
+func (s *memoryStore) listArticles(filter ArticleFilter) dbmodels.ArticleSlice {
+	var articles dbmodels.ArticleSlice
+	for _, a := range s.articles {
+		if filter.AuthorID != 0 && a.AuthorID != filter.AuthorID {
+			continue
+		}
+		if filter.Published && a.Status != dbmodels.ArticleStatusPublished {
+			continue
+		}
+		if !filter.Deleted.match(a.DeletedAt) {
+			continue
+		}
+		if !filter.Query.Match(&a) {
+			continue
+		}
+		a := a
+		articles = append(articles, &a)
+	}
+
+	sort.Slice(articles, func(i, j int) bool { return articles[i].ID < articles[j].ID })
+	if filter.Query.Sort != "" {
+		sort.SliceStable(articles, func(i, j int) bool { return filter.Query.Less(articles[i], articles[j]) })
+	}
+	start, end := window(len(articles), filter.Limit, filter.Offset)
+	return articles[start:end]
+}
+
+// checkAuthor enforces fk_author_id for a row written to article. It must
+// be called with s.mu held.
+func (s *memoryStore) checkAuthor(authorID int) error {
+	if _, ok := s.authors[authorID]; ok {
+		return nil
+	}
+
+	return db.Translate(&pq.Error{
+		Severity:   "ERROR",
+		Code:       "23503",
+		Message:    `insert or update on table "article" violates foreign key constraint "fk_author_id"`,
+		Detail:     fmt.Sprintf(`Key (author_id)=(%d) is not present in table "author".`, authorID),
+		Table:      dbmodels.TableNames.Article,
+		Constraint: "fk_author_id",
+	}, dbmodels.TableNames.Article)
+}
+
+// now returns the current time with the microsecond precision Postgres
+// stores timestamps with.
+func now() null.Time {
+	return null.TimeFrom(time.Now().Truncate(time.Microsecond))
+}
+
+func notFound(table string) error {
+	return db.Translate(sql.ErrNoRows, table)
+}
+
+// window returns the bounds of the page selected by limit and offset in a
+// list of n rows.
+func window(n, limit, offset int) (start, end int) {
+	start = offset
+	if start > n {
+		start = n
+	}
+	end = n
+	if limit > 0 && start+limit < n {
+		end = start + limit
+	}
+	return start, end
+}
+
+// stripAuthor drops loaded relationships so they aren't kept in the store.
+func stripAuthor(a dbmodels.Author) dbmodels.Author {
+	a.R = nil
+	return a
+}
+
+// stripArticle drops loaded relationships so they aren't kept in the store.
+func stripArticle(a dbmodels.Article) dbmodels.Article {
+	a.R = nil
+	return a
+}
@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/db/pagination"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NewPostgres returns repositories running the generated queries against
+// exec, which may be a *sql.DB or a transaction. A nil signer signs cursors
+// with a random key.
+func NewPostgres(exec boil.ContextExecutor, signer *pagination.Signer) Repositories {
+	if signer == nil {
+		signer = pagination.NewSigner(nil)
+	}
+
+	return Repositories{
+		Authors:  postgresAuthors{exec: exec, signer: signer},
+		Articles: postgresArticles{exec: exec, signer: signer},
+	}
+}
+
+type postgresAuthors struct {
+	exec   boil.ContextExecutor
+	signer *pagination.Signer
+}
+
+func (r postgresAuthors) Find(ctx context.Context, id int) (*dbmodels.Author, error) {
+	author, err := dbmodels.FindAuthor(ctx, r.exec, id)
+	return author, db.Translate(err, dbmodels.TableNames.Author)
+}
+
+func (r postgresAuthors) FindWithArticles(ctx context.Context, id int) (*dbmodels.Author, error) {
+	author, err := dbmodels.Authors(
+		dbmodels.AuthorWhere.ID.EQ(id),
+		qm.Load(dbmodels.AuthorRels.Articles, qm.OrderBy(dbmodels.ArticleColumns.ID)),
+	).One(ctx, r.exec)
+	return author, db.Translate(err, dbmodels.TableNames.Author)
+}
+
+func (r postgresAuthors) List(ctx context.Context, filter AuthorFilter) (dbmodels.AuthorSlice, error) {
+	mods := []qm.QueryMod{filter.orderBy()}
+	mods = append(mods, filter.mods()...)
+	mods = append(mods, paginate(filter.Limit, filter.Offset)...)
+
+	authors, err := dbmodels.Authors(mods...).All(ctx, r.exec)
+	return authors, db.Translate(err, dbmodels.TableNames.Author)
+}
+
+func (r postgresAuthors) Page(ctx context.Context, filter AuthorFilter, p pagination.Params) (pagination.Page[*dbmodels.Author], error) {
+	page, err := r.signer.Authors(ctx, r.exec, p, filter.mods()...)
+	return page, db.Translate(err, dbmodels.TableNames.Author)
+}
+
+// The writes of authors and articles run in a transaction with the audit
+// log entries their hooks record, see dbmodels.WithActor, so a change is
+// only made if it is recorded.
+
+func (r postgresAuthors) Insert(ctx context.Context, author *dbmodels.Author) error {
+	return inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		err := author.Insert(ctx, tx, boil.Infer())
+		return db.Translate(err, dbmodels.TableNames.Author)
+	})
+}
+
+func (r postgresAuthors) Update(ctx context.Context, author *dbmodels.Author, columns ...string) error {
+	set, err := authorUpdateSet(columns)
+	if err != nil {
+		return err
+	}
+
+	return inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		n, err := author.Update(ctx, tx, boil.Whitelist(set...))
+		if err == nil && n == 0 {
+			err = sql.ErrNoRows
+		}
+		return db.Translate(err, dbmodels.TableNames.Author)
+	})
+}
+
+func (r postgresAuthors) Delete(ctx context.Context, id int) error {
+	// Locking the author makes articles inserted for it meanwhile wait for
+	// fk_author_id, or be seen by the check if they came first.
+	return inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		author, err := dbmodels.Authors(dbmodels.AuthorWhere.ID.EQ(id), qm.For("UPDATE")).One(ctx, tx)
+		if err != nil {
+			return db.Translate(err, dbmodels.TableNames.Author)
+		}
+
+		referenced, err := dbmodels.Articles(dbmodels.ArticleWhere.AuthorID.EQ(id)).Exists(ctx, tx)
+		if err != nil {
+			return db.Translate(err, dbmodels.TableNames.Article)
+		}
+		if referenced {
+			return authorReferenced(id)
+		}
+
+		_, err = author.Delete(ctx, tx, false)
+		return db.Translate(err, dbmodels.TableNames.Author)
+	})
+}
+
+func (r postgresAuthors) Restore(ctx context.Context, id int) (*dbmodels.Author, error) {
+	var author *dbmodels.Author
+	err := inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		var err error
+		author, err = dbmodels.Authors(
+			dbmodels.OnlyDeleted[dbmodels.Author](),
+			dbmodels.AuthorWhere.ID.EQ(id),
+		).One(ctx, tx)
+		if err == nil {
+			_, err = author.Restore(ctx, tx)
+		}
+		return db.Translate(err, dbmodels.TableNames.Author)
+	})
+	return author, err
+}
+
+func (r postgresAuthors) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	n, err := dbmodels.PurgeAuthors(ctx, r.exec, cutoff)
+	return n, db.Translate(err, dbmodels.TableNames.Author)
+}
+
+type postgresArticles struct {
+	exec   boil.ContextExecutor
+	signer *pagination.Signer
+}
+
+func (r postgresArticles) Find(ctx context.Context, id int) (*dbmodels.Article, error) {
+	article, err := dbmodels.FindArticle(ctx, r.exec, id)
+	return article, db.Translate(err, dbmodels.TableNames.Article)
+}
+
+func (r postgresArticles) List(ctx context.Context, filter ArticleFilter) (dbmodels.ArticleSlice, error) {
+	mods := []qm.QueryMod{filter.orderBy()}
+	mods = append(mods, filter.mods()...)
+	mods = append(mods, paginate(filter.Limit, filter.Offset)...)
+
+	articles, err := dbmodels.Articles(mods...).All(ctx, r.exec)
+	return articles, db.Translate(err, dbmodels.TableNames.Article)
+}
+
+func (r postgresArticles) Page(ctx context.Context, filter ArticleFilter, p pagination.Params) (pagination.Page[*dbmodels.Article], error) {
+	page, err := r.signer.Articles(ctx, r.exec, p, filter.mods()...)
+	return page, db.Translate(err, dbmodels.TableNames.Article)
+}
+
+func (r postgresArticles) Insert(ctx context.Context, article *dbmodels.Article) error {
+	return inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		err := article.Insert(ctx, tx, boil.Infer())
+		return db.Translate(err, dbmodels.TableNames.Article)
+	})
+}
+
+func (r postgresArticles) Update(ctx context.Context, article *dbmodels.Article, columns ...string) error {
+	set, err := articleUpdateSet(columns)
+	if err != nil {
+		return err
+	}
+
+	return inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		n, err := article.Update(ctx, tx, boil.Whitelist(set...))
+		if err == nil && n == 0 {
+			err = sql.ErrNoRows
+		}
+		return db.Translate(err, dbmodels.TableNames.Article)
+	})
+}
+
+func (r postgresArticles) Transition(ctx context.Context, article *dbmodels.Article, status dbmodels.ArticleStatus, at time.Time) error {
+	return inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		err := article.Transition(ctx, tx, status, at)
+		return db.Translate(err, dbmodels.TableNames.Article)
+	})
+}
+
+func (r postgresArticles) Delete(ctx context.Context, id int) error {
+	return inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		article, err := dbmodels.FindArticle(ctx, tx, id)
+		if err == nil {
+			_, err = article.Delete(ctx, tx, false)
+		}
+		return db.Translate(err, dbmodels.TableNames.Article)
+	})
+}
+
+func (r postgresArticles) DeleteAll(ctx context.Context, ids ...int) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var n int64
+	err := inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		// Load the rows first rather than deleting by query so the delete
+		// hooks run for every article.
+		articles, err := dbmodels.Articles(dbmodels.ArticleWhere.ID.IN(ids)).All(ctx, tx)
+		if err != nil {
+			return db.Translate(err, dbmodels.TableNames.Article)
+		}
+
+		n, err = articles.DeleteAll(ctx, tx, false)
+		return db.Translate(err, dbmodels.TableNames.Article)
+	})
+	return n, err
+}
+
+func (r postgresArticles) Restore(ctx context.Context, id int) (*dbmodels.Article, error) {
+	var article *dbmodels.Article
+	err := inTx(ctx, r.exec, func(tx boil.ContextExecutor) error {
+		var err error
+		article, err = dbmodels.Articles(
+			dbmodels.OnlyDeleted[dbmodels.Article](),
+			dbmodels.ArticleWhere.ID.EQ(id),
+		).One(ctx, tx)
+		if err == nil {
+			_, err = article.Restore(ctx, tx)
+		}
+		return db.Translate(err, dbmodels.TableNames.Article)
+	})
+	return article, err
+}
+
+func (r postgresArticles) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	n, err := dbmodels.PurgeArticles(ctx, r.exec, cutoff)
+	return n, db.Translate(err, dbmodels.TableNames.Article)
+}
+
+// inTx runs fn in a transaction on exec, or in a savepoint if exec is a
+// transaction of db.WithTx. Other transactions are used as they are.
+func inTx(ctx context.Context, exec boil.ContextExecutor, fn func(tx boil.ContextExecutor) error) error {
+	if _, ok := exec.(*sql.Tx); ok {
+		return fn(exec)
+	}
+	return db.WithTx(ctx, exec, fn)
+}
+
+// mods returns the where clauses selecting the authors matching f.
+func (f AuthorFilter) mods() []qm.QueryMod {
+	var mods []qm.QueryMod
+	if f.Name != "" {
+		mods = append(mods, dbmodels.AuthorWhere.Name.EQ(f.Name))
+	}
+	if f.Email != "" {
+		mods = append(mods, dbmodels.AuthorWhere.Email.EQ(f.Email))
+	}
+	mods = append(mods, deletedMods[dbmodels.Author](f.Deleted)...)
+	return append(mods, f.Query.Where()...)
+}
+
+// orderBy returns the order by clause of the sort order of f.
+func (f AuthorFilter) orderBy() qm.QueryMod {
+	if f.Query.Sort == "" {
+		return qm.OrderBy(dbmodels.AuthorColumns.ID)
+	}
+	return f.Query.OrderBy()
+}
+
+// mods returns the where clauses selecting the articles matching f.
+func (f ArticleFilter) mods() []qm.QueryMod {
+	var mods []qm.QueryMod
+	if f.AuthorID != 0 {
+		mods = append(mods, dbmodels.ArticleWhere.AuthorID.EQ(f.AuthorID))
+	}
+	if f.Published {
+		mods = append(mods, dbmodels.ArticleIsPublished())
+	}
+	mods = append(mods, deletedMods[dbmodels.Article](f.Deleted)...)
+	return append(mods, f.Query.Where()...)
+}
+
+// orderBy returns the order by clause of the sort order of f.
+func (f ArticleFilter) orderBy() qm.QueryMod {
+	if f.Query.Sort == "" {
+		return qm.OrderBy(dbmodels.ArticleColumns.ID)
+	}
+	return f.Query.OrderBy()
+}
+
+// deletedMods returns the query mods selecting the rows of the table of T
+// in scope d.
+func deletedMods[T dbmodels.Model](d Deleted) []qm.QueryMod {
+	switch d {
+	case IncludeDeleted:
+		return []qm.QueryMod{qm.WithDeleted()}
+	case OnlyDeleted:
+		return []qm.QueryMod{dbmodels.OnlyDeleted[T]()}
+	}
+	return nil
+}
+
+func paginate(limit, offset int) []qm.QueryMod {
+	var mods []qm.QueryMod
+	if limit > 0 {
+		mods = append(mods, qm.Limit(limit))
+	}
+	if offset > 0 {
+		mods = append(mods, qm.Offset(offset))
+	}
+	return mods
+}
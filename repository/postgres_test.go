@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestPostgresAuthorsDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		articles int
+		err      error
+	}{
+		{"unreferenced", 0, nil},
+		{"referenced", 1, db.ErrReferenced},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT "author"\.\* FROM "author" WHERE .* FOR UPDATE`).WithArgs(1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "email", "name"}).AddRow(1, "jane@example.com", "Jane"))
+			mock.ExpectQuery(`SELECT .* FROM "article" WHERE \("article"\."author_id" = \$1\)`).WithArgs(1).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tt.articles))
+			if tt.articles > 0 {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectQuery(`SELECT \(SELECT to_jsonb\("row"\) FROM \(SELECT .* FROM "author" WHERE "id" = \$1 FOR UPDATE\) AS "row"\)`).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow(`{"id": 1}`))
+				mock.ExpectExec(`UPDATE "author" SET "deleted_at"`).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO "audit_log"`).
+					WithArgs(sqlmock.AnyArg(), dbmodels.AuditOperationDelete, "author", 1, null.JSONFrom([]byte(`{"id": 1}`))).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			err = NewPostgres(conn, nil).Authors.Delete(context.Background(), 1)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Delete() = %v, want %v", err, tt.err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPostgresArticlesAuditFailure(t *testing.T) {
+	const before = `SELECT \(SELECT to_jsonb\("row"\) FROM \(SELECT .* FROM "article" WHERE "id" = \$1 FOR UPDATE\)`
+	auditErr := errors.New("audit_log is full")
+	article := &dbmodels.Article{ID: 1, Title: "Hello", Version: 2}
+
+	tests := []struct {
+		name   string
+		expect func(mock sqlmock.Sqlmock)
+		write  func(r Repositories) error
+	}{
+		{
+			"update",
+			func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(before).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow(`{"id": 1}`))
+				mock.ExpectExec(`UPDATE "article" SET "title"`).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			func(r Repositories) error {
+				return r.Articles.Update(context.Background(), article, dbmodels.ArticleColumns.Title)
+			},
+		},
+		{
+			"delete",
+			func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`select \* from "article" where "id"=\$1`).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "version"}).AddRow(1, "Hello", 2))
+				mock.ExpectQuery(before).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow(`{"id": 1}`))
+				mock.ExpectExec(`UPDATE "article" SET "deleted_at"`).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			func(r Repositories) error {
+				return r.Articles.Delete(context.Background(), 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			// The change is rolled back with the audit log entry that
+			// failed to record it.
+			mock.ExpectBegin()
+			tt.expect(mock)
+			mock.ExpectExec(`INSERT INTO "audit_log"`).WillReturnError(auditErr)
+			mock.ExpectRollback()
+
+			if err := tt.write(NewPostgres(conn, nil)); !errors.Is(err, auditErr) {
+				t.Fatalf("write = %v, want %v", err, auditErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestArticlesUpdateColumns(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	// Without columns, Update writes the title, body and author_id, never
+	// the status, which only changes with Transition.
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \(SELECT to_jsonb`).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow(`{"id": 1}`))
+	mock.ExpectExec(`UPDATE "article" SET "title"=\$1,"body"=\$2,"author_id"=\$3,"updated_at"=\$4 WHERE "id"=\$5 AND "version"=\$6`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "audit_log"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "article_revision"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	article := &dbmodels.Article{ID: 1, Title: "Hello", AuthorID: 1, Status: dbmodels.ArticleStatusPublished}
+	if err := NewPostgres(conn, nil).Articles.Update(context.Background(), article); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	// Both repositories refuse the columns Update doesn't write.
+	memory := NewMemory(nil)
+	if err := memory.Authors.Insert(context.Background(), &dbmodels.Author{Name: "Jane", Email: "jane@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := memory.Articles.Insert(context.Background(), &dbmodels.Article{Title: "Hello", AuthorID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	for _, col := range []string{dbmodels.ArticleColumns.Status, dbmodels.ArticleColumns.PublishedAt, dbmodels.ArticleColumns.DeletedAt} {
+		article := &dbmodels.Article{ID: 1, Title: "Hello", AuthorID: 1, Status: dbmodels.ArticleStatusPublished}
+		for name, repos := range map[string]Repositories{"postgres": NewPostgres(conn, nil), "memory": memory} {
+			if err := repos.Articles.Update(context.Background(), article, dbmodels.ArticleColumns.Title, col); err == nil {
+				t.Errorf("%s Update() of %s = nil, want an error", name, col)
+			}
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,225 @@
+// Package repository puts interfaces in front of the generated models so
+// code using them can be tested without Postgres.
+//
+// NewPostgres wraps the functions generated in db/models, NewMemory keeps
+// everything in memory while honouring the same semantics: ids are assigned
+// from a sequence, created_at defaults to the insert time, updated_at is set
+// by inserts, updates and restores, deletes are soft,
+// articles are locked optimistically by their version, start as drafts and
+// change status along dbmodels.ArticleTransitions, and the fk_author_id
+// constraint is enforced. Both return errors translated by
+// db.Translate, so missing rows fail with db.ErrNotFound and constraint
+// violations with db.ErrInvalidReference, db.ErrReferenced and friends.
+// Both sign their page cursors with the pagination.Signer they were
+// created with.
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	"github.com/gurleensethi/go-sql-boiler-example/db/filter"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/db/pagination"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
+)
+
+// AuthorRepository stores authors.
+type AuthorRepository interface {
+	// Find returns the author with the given id.
+	Find(ctx context.Context, id int) (*dbmodels.Author, error)
+	// FindWithArticles returns the author with the given id, with the
+	// author's articles loaded into R.Articles.
+	FindWithArticles(ctx context.Context, id int) (*dbmodels.Author, error)
+	// List returns the authors matching filter, ordered by the sort order
+	// of its Query.
+	List(ctx context.Context, filter AuthorFilter) (dbmodels.AuthorSlice, error)
+	// Page returns the page of the authors matching filter selected by p.
+	// The Limit and Offset and the sort order of filter are ignored.
+	Page(ctx context.Context, filter AuthorFilter, p pagination.Params) (pagination.Page[*dbmodels.Author], error)
+	// Insert stores a new author, setting its id and updated_at.
+	Insert(ctx context.Context, author *dbmodels.Author) error
+	// Update writes the given columns of author, or its name and email if
+	// none are given, and sets its updated_at. Other columns can't be
+	// written.
+	Update(ctx context.Context, author *dbmodels.Author, columns ...string) error
+	// Delete soft deletes the author with the given id. Like a hard delete
+	// would, it fails with db.ErrReferenced while the author has articles
+	// that aren't deleted.
+	Delete(ctx context.Context, id int) error
+	// Restore undeletes the soft deleted author with the given id and
+	// returns it. It fails with db.ErrNotFound if there is no deleted
+	// author with that id.
+	Restore(ctx context.Context, id int) (*dbmodels.Author, error)
+	// Purge removes the authors deleted before cutoff for good, except for
+	// those that still have articles, and returns how many were removed.
+	Purge(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// ArticleRepository stores articles.
+type ArticleRepository interface {
+	// Find returns the article with the given id.
+	Find(ctx context.Context, id int) (*dbmodels.Article, error)
+	// List returns the articles matching filter, ordered by the sort order
+	// of its Query.
+	List(ctx context.Context, filter ArticleFilter) (dbmodels.ArticleSlice, error)
+	// Page returns the page of the articles matching filter selected by p.
+	// The Limit and Offset and the sort order of filter are ignored.
+	Page(ctx context.Context, filter ArticleFilter, p pagination.Params) (pagination.Page[*dbmodels.Article], error)
+	// Insert stores a new article, setting its id, created_at and
+	// updated_at.
+	Insert(ctx context.Context, article *dbmodels.Article) error
+	// Update writes the given columns of article, or its title, body and
+	// author_id if none are given, increments its version and sets its
+	// updated_at. created_at is only written when it is given, the other
+	// columns can't be written: status and published_at only change with
+	// Transition, deleted_at with Delete and Restore. It fails with
+	// dbmodels.ErrStaleObject if the article was changed or deleted since
+	// article.Version was loaded.
+	Update(ctx context.Context, article *dbmodels.Article, columns ...string) error
+	// Transition moves article to status like dbmodels.Article.Transition
+	// and writes it, failing with a *dbmodels.TransitionError if the
+	// article can't reach status and with dbmodels.ErrStaleObject like
+	// Update does.
+	Transition(ctx context.Context, article *dbmodels.Article, status dbmodels.ArticleStatus, at time.Time) error
+	// Delete soft deletes the article with the given id.
+	Delete(ctx context.Context, id int) error
+	// DeleteAll soft deletes the articles with the given ids and returns
+	// how many were deleted. Ids that don't exist or are already deleted
+	// are ignored.
+	DeleteAll(ctx context.Context, ids ...int) (int64, error)
+	// Restore undeletes the soft deleted article with the given id and
+	// returns it. It fails with db.ErrNotFound if there is no deleted
+	// article with that id.
+	Restore(ctx context.Context, id int) (*dbmodels.Article, error)
+	// Purge removes the articles deleted before cutoff for good and
+	// returns how many were removed.
+	Purge(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// Deleted selects the rows List and Page return with regard to soft
+// deletes.
+type Deleted int
+
+const (
+	// ExcludeDeleted leaves out soft deleted rows.
+	ExcludeDeleted Deleted = iota
+	// IncludeDeleted returns soft deleted rows along with the others.
+	IncludeDeleted
+	// OnlyDeleted returns nothing but soft deleted rows.
+	OnlyDeleted
+)
+
+// ParseDeleted parses the name of a Deleted: "exclude", "include" or
+// "only".
+func ParseDeleted(s string) (Deleted, error) {
+	switch s {
+	case "exclude":
+		return ExcludeDeleted, nil
+	case "include":
+		return IncludeDeleted, nil
+	case "only":
+		return OnlyDeleted, nil
+	}
+	return 0, fmt.Errorf("repository: invalid deleted scope %q, use exclude, include or only", s)
+}
+
+// match reports whether a row with the given deleted_at is selected by d.
+func (d Deleted) match(deletedAt null.Time) bool {
+	switch d {
+	case IncludeDeleted:
+		return true
+	case OnlyDeleted:
+		return deletedAt.Valid
+	}
+	return !deletedAt.Valid
+}
+
+// AuthorFilter narrows down the authors returned by List. Zero values
+// don't filter, soft deleted authors are left out unless Deleted says
+// otherwise.
+type AuthorFilter struct {
+	Name  string
+	Email string
+	// Query holds filters and a sort order parsed with filter.Authors, the
+	// zero Query orders by id.
+	Query   filter.Query
+	Deleted Deleted
+	Limit   int
+	Offset  int
+}
+
+// ArticleFilter narrows down the articles returned by List. Zero values
+// don't filter, soft deleted articles are left out unless Deleted says
+// otherwise.
+type ArticleFilter struct {
+	AuthorID int
+	// Published leaves out the articles that aren't published, which
+	// readers shouldn't see.
+	Published bool
+	// Query holds filters and a sort order parsed with filter.Articles,
+	// the zero Query orders by id.
+	Query   filter.Query
+	Deleted Deleted
+	Limit   int
+	Offset  int
+}
+
+// Repositories bundles the repositories of one backend.
+type Repositories struct {
+	Authors  AuthorRepository
+	Articles ArticleRepository
+}
+
+// authorReferenced returns the error Postgres reports when the author with
+// the given id is deleted while articles reference it. Soft deletes don't
+// trip fk_author_id, so both backends check for articles themselves.
+func authorReferenced(id int) error {
+	return db.Translate(&pq.Error{
+		Severity:   "ERROR",
+		Code:       "23503",
+		Message:    `update or delete on table "author" violates foreign key constraint "fk_author_id" on table "article"`,
+		Detail:     fmt.Sprintf(`Key (id)=(%d) is still referenced from table "article".`, id),
+		Table:      dbmodels.TableNames.Article,
+		Constraint: "fk_author_id",
+	}, dbmodels.TableNames.Author)
+}
+
+// authorUpdateSet returns the columns Update writes for the given columns,
+// see AuthorRepository.Update.
+func authorUpdateSet(columns []string) ([]string, error) {
+	if len(columns) == 0 {
+		return []string{dbmodels.AuthorColumns.Email, dbmodels.AuthorColumns.Name}, nil
+	}
+
+	for _, col := range columns {
+		switch col {
+		case dbmodels.AuthorColumns.Email, dbmodels.AuthorColumns.Name:
+		default:
+			return nil, fmt.Errorf("repository: author column %q can't be updated", col)
+		}
+	}
+	return columns, nil
+}
+
+// articleUpdateSet returns the columns Update writes for the given columns,
+// see ArticleRepository.Update.
+func articleUpdateSet(columns []string) ([]string, error) {
+	if len(columns) == 0 {
+		return []string{dbmodels.ArticleColumns.Title, dbmodels.ArticleColumns.Body, dbmodels.ArticleColumns.AuthorID}, nil
+	}
+
+	for _, col := range columns {
+		switch col {
+		case dbmodels.ArticleColumns.Title, dbmodels.ArticleColumns.Body, dbmodels.ArticleColumns.AuthorID, dbmodels.ArticleColumns.CreatedAt:
+		case dbmodels.ArticleColumns.Status, dbmodels.ArticleColumns.PublishedAt:
+			return nil, fmt.Errorf("repository: article column %q only changes with Transition", col)
+		default:
+			return nil, fmt.Errorf("repository: article column %q can't be updated", col)
+		}
+	}
+	return columns, nil
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/diff"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func newArticlesRevisionsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revisions <id>",
+		Short: "List the revisions of an article, newest first",
+		Long: `List the revisions of an article, newest first. A revision is recorded
+whenever the title or body of the article changes and is named after the
+version of the article that introduced it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			article, err := dbmodels.FindArticleG(cmd.Context(), id)
+			if err != nil {
+				return db.Translate(err, dbmodels.TableNames.Article)
+			}
+
+			revisions, err := article.History().AllG(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, revisions, revisionTable(revisions...))
+		},
+	}
+}
+
+func newArticlesDiffCmd() *cobra.Command {
+	var words bool
+
+	cmd := &cobra.Command{
+		Use:   "diff <id> <from-version> [to-version]",
+		Short: "Show the changes to the body of an article between two revisions",
+		Long: `Show the changes to the body of an article between two of its revisions
+as a unified diff, or inline with --words. to-version defaults to the
+latest revision.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+			versions := make([]int, len(args)-1)
+			for i, arg := range args[1:] {
+				if versions[i], err = parseVersion(arg); err != nil {
+					return err
+				}
+			}
+
+			from, to, err := revisionsToDiff(cmd.Context(), boil.GetContextDB(), id, versions)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if words {
+				_, err = fmt.Fprintln(out, diff.Words(from.Body.String, to.Body.String))
+				return err
+			}
+			_, err = fmt.Fprint(out, diff.Unified(revisionName(from), revisionName(to), from.Body.String, to.Body.String))
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&words, "words", false, "show the changed words inline instead of a unified diff")
+
+	return cmd
+}
+
+func newArticlesRevertCmd() *cobra.Command {
+	var version int
+
+	cmd := &cobra.Command{
+		Use:   "revert <id> <version>",
+		Short: "Set the title and body of an article back to a revision",
+		Long: `Set the title and body of an article back to those of one of its
+revisions. The result is recorded as a new revision, so a revert can be
+reverted as well.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+			revision, err := parseVersion(args[1])
+			if err != nil {
+				return err
+			}
+
+			var article *dbmodels.Article
+			err = db.WithTx(cmd.Context(), boil.GetContextDB(), func(tx boil.ContextExecutor) error {
+				article, err = dbmodels.FindArticle(cmd.Context(), tx, id)
+				if err != nil {
+					return db.Translate(err, dbmodels.TableNames.Article)
+				}
+				if cmd.Flags().Changed("expect-version") {
+					article.Version = version
+				}
+
+				if _, err := article.Revert(cmd.Context(), tx, revision); err != nil {
+					return db.Translate(err, dbmodels.TableNames.ArticleRevision)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, article, articleTable(article))
+		},
+	}
+
+	cmd.Flags().IntVar(&version, "expect-version", 0, "only revert the article if it is still at this version")
+
+	return cmd
+}
+
+// parseVersion parses the version of a revision.
+func parseVersion(s string) (int, error) {
+	version, err := parseID(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q", s)
+	}
+	return version, nil
+}
+
+// revisionsToDiff loads the revisions of the article with the given id at
+// versions, the latest one standing in for a missing second version.
+func revisionsToDiff(ctx context.Context, exec boil.ContextExecutor, id int, versions []int) (from, to *dbmodels.ArticleRevision, err error) {
+	from, err = dbmodels.FindArticleRevision(ctx, exec, id, versions[0])
+	if err != nil {
+		return nil, nil, db.Translate(err, dbmodels.TableNames.ArticleRevision)
+	}
+
+	if len(versions) > 1 {
+		to, err = dbmodels.FindArticleRevision(ctx, exec, id, versions[1])
+	} else {
+		to, err = dbmodels.ArticleRevisions(
+			dbmodels.ArticleRevisionWhere.ArticleID.EQ(id),
+			qm.OrderBy(`"article_revision"."version" DESC`),
+		).One(ctx, exec)
+	}
+	if err != nil {
+		return nil, nil, db.Translate(err, dbmodels.TableNames.ArticleRevision)
+	}
+
+	return from, to, nil
+}
+
+// revisionName names a revision in the header of a unified diff.
+func revisionName(r *dbmodels.ArticleRevision) string {
+	return fmt.Sprintf("article %d version %d", r.ArticleID, r.Version)
+}
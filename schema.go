@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db/schema"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func newSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Inspect the database schema",
+		Annotations: map[string]string{
+			annotationNoSchemaCheck: "true",
+		},
+	}
+
+	cmd.AddCommand(newSchemaCheckCmd())
+
+	return cmd
+}
+
+func newSchemaCheckCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check",
+		Short: "Compare the database against the generated models",
+		Long: `Compare the tables, columns, primary keys and foreign keys of the
+database against the generated models and list every difference. Exits with
+a non-zero status if there are any.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			problems, err := schema.Check(cmd.Context(), boil.GetContextDB())
+			if err != nil {
+				return err
+			}
+
+			t := table{headers: []string{"TABLE", "COLUMN", "KIND", "DETAIL"}}
+			for _, p := range problems {
+				t.rows = append(t.rows, []string{p.Table, p.Column, p.Kind, p.Detail})
+			}
+			if err := printResult(cmd, problems, t); err != nil {
+				return err
+			}
+
+			if len(problems) > 0 {
+				return fmt.Errorf("found %d differences between the database and the models", len(problems))
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func newSearchCmd() *cobra.Command {
+	var (
+		opts        dbmodels.SearchOptions
+		authorID    int
+		unpublished bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "search <query>...",
+		Short: "Search the title and body of articles",
+		Long: `Search the title and body of articles, best matches first. All words
+must match, "quoted words" must appear next to each other, word* matches
+words starting with word, -word excludes articles and OR matches either
+side, e.g.
+
+  search '"keyset pagination" postgres* -mysql'
+
+Only published articles are searched unless --unpublished is given.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Language == "" {
+				opts.Language = searchLanguage
+			}
+			if authorID != 0 {
+				opts.Mods = append(opts.Mods, dbmodels.ArticleWhere.AuthorID.EQ(authorID))
+			}
+			if unpublished {
+				opts.Mods = append(opts.Mods, dbmodels.WithUnpublished())
+			}
+			// Highlight matches in a way that survives the table output.
+			if opts.HeadlineOptions == "" {
+				opts.HeadlineOptions = "StartSel=**, StopSel=**"
+			}
+
+			results, err := dbmodels.SearchArticles(cmd.Context(), boil.GetContextDB(), strings.Join(args, " "), opts)
+			if err != nil {
+				return err
+			}
+
+			t := table{headers: []string{"ID", "TITLE", "AUTHOR ID", "RANK", "HEADLINE"}}
+			for _, r := range results {
+				t.rows = append(t.rows, []string{
+					strconv.Itoa(r.Article.ID),
+					r.Article.Title,
+					strconv.Itoa(r.Article.AuthorID),
+					strconv.FormatFloat(float64(r.Rank), 'f', 3, 32),
+					truncate(r.Headline, 60),
+				})
+			}
+
+			return printResult(cmd, results, t)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Language, "language", "", "text search configuration (default from --search-language)")
+	cmd.Flags().StringVar(&opts.HeadlineOptions, "headline-options", "", `ts_headline options, e.g. "MaxFragments=2"`)
+	cmd.Flags().IntVar(&opts.Limit, "limit", dbmodels.DefaultSearchLimit, "maximum number of results")
+	cmd.Flags().IntVar(&opts.Offset, "offset", 0, "number of results to skip")
+	cmd.Flags().IntVar(&authorID, "author-id", 0, "only search articles of this author")
+	cmd.Flags().BoolVar(&unpublished, "unpublished", false, "also search articles that aren't published")
+
+	return cmd
+}
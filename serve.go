@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/server"
+	"github.com/spf13/cobra"
+)
+
+func newServeCmd() *cobra.Command {
+	var addr string
+	var shutdownTimeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the authors and articles REST API",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			srv := &http.Server{
+				Addr:    addr,
+				Handler: server.New(repos(), server.Editors(editors)),
+			}
+
+			errc := make(chan error, 1)
+			go func() {
+				log.Printf("listening on %s", addr)
+				errc <- srv.ListenAndServe()
+			}()
+
+			select {
+			case err := <-errc:
+				return err
+			case <-ctx.Done():
+			}
+
+			log.Printf("shutting down")
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+
+			if err := srv.Shutdown(shutdownCtx); err != nil {
+				return err
+			}
+			if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&addr, "addr", ":8080", "address to listen on")
+	cmd.Flags().DurationVar(&shutdownTimeout, "shutdown-timeout", 10*time.Second, "time to wait for in-flight requests on shutdown")
+
+	return cmd
+}
@@ -0,0 +1,267 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db/filter"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/repository"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// articleInput is the request body for creating and updating an article.
+// Absent fields are left unchanged on update.
+type articleInput struct {
+	Title     *string    `json:"title"`
+	Body      *string    `json:"body"`
+	AuthorID  *int       `json:"author_id"`
+	CreatedAt *time.Time `json:"created_at"`
+	// Version is the version of the article an update is based on, the
+	// update fails with 409 Conflict if the article was changed since.
+	// Without it the update overwrites any changes. It is ignored on
+	// create.
+	Version *int `json:"version"`
+}
+
+// apply validates in and copies the fields that are set onto article,
+// returning the names of the changed columns.
+func (in articleInput) apply(article *dbmodels.Article) ([]string, error) {
+	var cols []string
+
+	if in.Title != nil {
+		if strings.TrimSpace(*in.Title) == "" {
+			return nil, validationError{field: "title", msg: "must not be empty"}
+		}
+		article.Title = *in.Title
+		cols = append(cols, dbmodels.ArticleColumns.Title)
+	}
+	if in.Body != nil {
+		article.Body = null.StringFrom(*in.Body)
+		cols = append(cols, dbmodels.ArticleColumns.Body)
+	}
+	if in.AuthorID != nil {
+		if *in.AuthorID <= 0 {
+			return nil, validationError{field: "author_id", msg: "must be a positive integer"}
+		}
+		article.AuthorID = *in.AuthorID
+		cols = append(cols, dbmodels.ArticleColumns.AuthorID)
+	}
+	if in.CreatedAt != nil {
+		article.CreatedAt = null.TimeFrom(*in.CreatedAt)
+		cols = append(cols, dbmodels.ArticleColumns.CreatedAt)
+	}
+
+	return cols, nil
+}
+
+// statusInput is the request body for changing the status of an article.
+type statusInput struct {
+	Status dbmodels.ArticleStatus `json:"status"`
+	// PublishAt is when a scheduled article is published, it is required
+	// for and only used by the scheduled status.
+	PublishAt *time.Time `json:"publish_at"`
+	// Version is the version of the article the change is based on, like
+	// for updates.
+	Version *int `json:"version"`
+}
+
+func (s *Server) handleArticles(w http.ResponseWriter, r *http.Request) {
+	id, rest, err := route(r, "/articles")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	switch {
+	case id == 0 && r.Method == http.MethodGet:
+		s.listArticles(w, r)
+	case id == 0 && r.Method == http.MethodPost:
+		s.createArticle(w, r)
+	case id == 0:
+		methodNotAllowed(w, http.MethodGet, http.MethodPost)
+	case len(rest) == 1 && rest[0] == "restore" && r.Method == http.MethodPost:
+		s.restoreArticle(w, r, id)
+	case len(rest) == 1 && rest[0] == "restore":
+		methodNotAllowed(w, http.MethodPost)
+	case len(rest) == 1 && rest[0] == "status" && r.Method == http.MethodPost:
+		s.transitionArticle(w, r, id)
+	case len(rest) == 1 && rest[0] == "status":
+		methodNotAllowed(w, http.MethodPost)
+	case len(rest) != 0:
+		writeError(w, errNotFound)
+	case r.Method == http.MethodGet:
+		s.getArticle(w, r, id)
+	case r.Method == http.MethodPatch:
+		s.updateArticle(w, r, id)
+	case r.Method == http.MethodDelete:
+		s.deleteArticle(w, r, id)
+	default:
+		methodNotAllowed(w, http.MethodGet, http.MethodPatch, http.MethodDelete)
+	}
+}
+
+func (s *Server) listArticles(w http.ResponseWriter, r *http.Request) {
+	q, params, err := listParams(r, filter.Articles)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	deleted, err := queryDeleted(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	published, err := queryPublished(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	page, err := s.articles.Page(r.Context(), repository.ArticleFilter{Published: published, Query: q, Deleted: deleted}, params)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, r, http.StatusOK, page)
+}
+
+func (s *Server) createArticle(w http.ResponseWriter, r *http.Request) {
+	var in articleInput
+	if err := decode(r, &in); err != nil {
+		writeError(w, err)
+		return
+	}
+	if in.Title == nil {
+		writeError(w, validationError{field: "title", msg: "is required"})
+		return
+	}
+	if in.AuthorID == nil {
+		writeError(w, validationError{field: "author_id", msg: "is required"})
+		return
+	}
+
+	var article dbmodels.Article
+	if _, err := in.apply(&article); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := s.articles.Insert(r.Context(), &article); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, r, http.StatusCreated, article)
+}
+
+func (s *Server) getArticle(w http.ResponseWriter, r *http.Request, id int) {
+	published, err := queryPublished(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	article, err := s.articles.Find(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if published && article.Status != dbmodels.ArticleStatusPublished {
+		writeError(w, errNotFound)
+		return
+	}
+
+	writeResult(w, r, http.StatusOK, article)
+}
+
+func (s *Server) updateArticle(w http.ResponseWriter, r *http.Request, id int) {
+	var in articleInput
+	if err := decode(r, &in); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	article, err := s.articles.Find(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	cols, err := in.apply(article)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if len(cols) != 0 {
+		if in.Version != nil {
+			article.Version = *in.Version
+		}
+		if err := s.articles.Update(r.Context(), article, cols...); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	writeResult(w, r, http.StatusOK, article)
+}
+
+func (s *Server) transitionArticle(w http.ResponseWriter, r *http.Request, id int) {
+	var in statusInput
+	if err := decode(r, &in); err != nil {
+		writeError(w, err)
+		return
+	}
+	if in.Status.IsValid() != nil {
+		writeError(w, validationError{field: "status", msg: "must be one of draft, in_review, scheduled, published or archived"})
+		return
+	}
+
+	at := time.Now().In(boil.GetLocation())
+	if in.Status == dbmodels.ArticleStatusScheduled {
+		if in.PublishAt == nil {
+			writeError(w, validationError{field: "publish_at", msg: "is required to schedule an article"})
+			return
+		}
+		at = *in.PublishAt
+	}
+
+	article, err := s.articles.Find(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if in.Version != nil {
+		article.Version = *in.Version
+	}
+
+	if err := s.articles.Transition(r.Context(), article, in.Status, at); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, r, http.StatusOK, article)
+}
+
+func (s *Server) deleteArticle(w http.ResponseWriter, r *http.Request, id int) {
+	if err := s.articles.Delete(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) restoreArticle(w http.ResponseWriter, r *http.Request, id int) {
+	article, err := s.articles.Restore(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, r, http.StatusOK, article)
+}
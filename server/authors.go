@@ -0,0 +1,247 @@
+package server
+
+import (
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db/filter"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/repository"
+)
+
+// authorInput is the request body for creating and updating an author.
+// Absent fields are left unchanged on update.
+type authorInput struct {
+	Name  *string `json:"name"`
+	Email *string `json:"email"`
+}
+
+// apply validates in and copies the fields that are set onto author,
+// returning the names of the changed columns.
+func (in authorInput) apply(author *dbmodels.Author) ([]string, error) {
+	var cols []string
+
+	if in.Name != nil {
+		if strings.TrimSpace(*in.Name) == "" {
+			return nil, validationError{field: "name", msg: "must not be empty"}
+		}
+		author.Name = *in.Name
+		cols = append(cols, dbmodels.AuthorColumns.Name)
+	}
+	if in.Email != nil {
+		if _, err := mail.ParseAddress(*in.Email); err != nil {
+			return nil, validationError{field: "email", msg: "must be a valid email address"}
+		}
+		author.Email = *in.Email
+		cols = append(cols, dbmodels.AuthorColumns.Email)
+	}
+
+	return cols, nil
+}
+
+// authorWithArticles is the response for an author with eager loaded
+// articles. The generated R struct is not serialized, so the articles are
+// nested explicitly.
+type authorWithArticles struct {
+	*dbmodels.Author
+	Articles dbmodels.ArticleSlice `json:"articles"`
+}
+
+func (s *Server) handleAuthors(w http.ResponseWriter, r *http.Request) {
+	id, rest, err := route(r, "/authors")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	switch {
+	case id == 0 && r.Method == http.MethodGet:
+		s.listAuthors(w, r)
+	case id == 0 && r.Method == http.MethodPost:
+		s.createAuthor(w, r)
+	case id == 0:
+		methodNotAllowed(w, http.MethodGet, http.MethodPost)
+	case len(rest) == 0 && r.Method == http.MethodGet:
+		s.getAuthor(w, r, id)
+	case len(rest) == 0 && r.Method == http.MethodPatch:
+		s.updateAuthor(w, r, id)
+	case len(rest) == 0 && r.Method == http.MethodDelete:
+		s.deleteAuthor(w, r, id)
+	case len(rest) == 0:
+		methodNotAllowed(w, http.MethodGet, http.MethodPatch, http.MethodDelete)
+	case len(rest) == 1 && rest[0] == "restore" && r.Method == http.MethodPost:
+		s.restoreAuthor(w, r, id)
+	case len(rest) == 1 && rest[0] == "restore":
+		methodNotAllowed(w, http.MethodPost)
+	case len(rest) == 1 && rest[0] == "articles" && r.Method == http.MethodGet:
+		s.listAuthorArticles(w, r, id)
+	case len(rest) == 1 && rest[0] == "articles":
+		methodNotAllowed(w, http.MethodGet)
+	default:
+		writeError(w, errNotFound)
+	}
+}
+
+func (s *Server) listAuthors(w http.ResponseWriter, r *http.Request) {
+	q, params, err := listParams(r, filter.Authors)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	deleted, err := queryDeleted(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	page, err := s.authors.Page(r.Context(), repository.AuthorFilter{Query: q, Deleted: deleted}, params)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, r, http.StatusOK, page)
+}
+
+func (s *Server) createAuthor(w http.ResponseWriter, r *http.Request) {
+	var in authorInput
+	if err := decode(r, &in); err != nil {
+		writeError(w, err)
+		return
+	}
+	if in.Name == nil {
+		writeError(w, validationError{field: "name", msg: "is required"})
+		return
+	}
+	if in.Email == nil {
+		writeError(w, validationError{field: "email", msg: "is required"})
+		return
+	}
+
+	var author dbmodels.Author
+	if _, err := in.apply(&author); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := s.authors.Insert(r.Context(), &author); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, r, http.StatusCreated, author)
+}
+
+func (s *Server) getAuthor(w http.ResponseWriter, r *http.Request, id int) {
+	withArticles := r.URL.Query().Get("include") == "articles"
+	published, err := queryPublished(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	find := s.authors.Find
+	if withArticles {
+		find = s.authors.FindWithArticles
+	}
+
+	author, err := find(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if !withArticles {
+		writeResult(w, r, http.StatusOK, author)
+		return
+	}
+
+	articles := dbmodels.ArticleSlice{}
+	for _, article := range author.R.GetArticles() {
+		if !published || article.Status == dbmodels.ArticleStatusPublished {
+			articles = append(articles, article)
+		}
+	}
+	writeResult(w, r, http.StatusOK, authorWithArticles{author, articles})
+}
+
+func (s *Server) updateAuthor(w http.ResponseWriter, r *http.Request, id int) {
+	var in authorInput
+	if err := decode(r, &in); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	author, err := s.authors.Find(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	cols, err := in.apply(author)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if len(cols) != 0 {
+		if err := s.authors.Update(r.Context(), author, cols...); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	writeResult(w, r, http.StatusOK, author)
+}
+
+func (s *Server) deleteAuthor(w http.ResponseWriter, r *http.Request, id int) {
+	if err := s.authors.Delete(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) restoreAuthor(w http.ResponseWriter, r *http.Request, id int) {
+	author, err := s.authors.Restore(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, r, http.StatusOK, author)
+}
+
+func (s *Server) listAuthorArticles(w http.ResponseWriter, r *http.Request, id int) {
+	q, params, err := listParams(r, filter.Articles)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	deleted, err := queryDeleted(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	published, err := queryPublished(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if _, err := s.authors.Find(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	page, err := s.articles.Page(r.Context(), repository.ArticleFilter{AuthorID: id, Published: published, Query: q, Deleted: deleted}, params)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, r, http.StatusOK, page)
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	"github.com/gurleensethi/go-sql-boiler-example/db/filter"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/db/pagination"
+)
+
+var (
+	errNotFound         = errors.New("not found")
+	errMethodNotAllowed = errors.New("method not allowed")
+	errInvalidToken     = errors.New("invalid token")
+)
+
+// validationError is returned when a request is malformed or carries an
+// invalid value. It is reported with 400 Bad Request.
+type validationError struct {
+	field string
+	msg   string
+}
+
+func (e validationError) Error() string {
+	if e.field == "" {
+		return e.msg
+	}
+	return e.field + ": " + e.msg
+}
+
+// editorsOnlyError is returned when an anonymous request sets a parameter
+// only editors may set. It is reported with 401 Unauthorized.
+type editorsOnlyError struct {
+	field string
+}
+
+func (e editorsOnlyError) Error() string {
+	return e.field + ": only editors may set it"
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+	Field string `json:"field,omitempty"`
+}
+
+// Messages for violations of constraints the API knows about. Other
+// constraints fall back to a generic message.
+var (
+	invalidReferenceMessages = map[string]string{
+		"fk_author_id": "author does not exist",
+	}
+	referencedMessages = map[string]string{
+		"fk_author_id": "author still has articles",
+	}
+)
+
+// writeError maps err onto a status code and writes it as a JSON error
+// response. Errors that don't map onto a client error are logged and
+// reported as 500 without leaking details.
+func writeError(w http.ResponseWriter, err error) {
+	var (
+		verr          validationError
+		editorsErr    editorsOnlyError
+		filterErr     *filter.Error
+		dbErr         *db.Error
+		transitionErr *dbmodels.TransitionError
+	)
+	errors.As(err, &dbErr)
+
+	switch {
+	case errors.As(err, &verr):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: verr.msg, Field: verr.field})
+	case errors.As(err, &filterErr):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: filterErr.Message, Field: filterErr.Field})
+	case errors.Is(err, errNotFound), errors.Is(err, db.ErrNotFound):
+		writeJSON(w, http.StatusNotFound, errorResponse{Error: "not found"})
+	case errors.Is(err, pagination.ErrInvalidCursor):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "invalid cursor", Field: "cursor"})
+	case errors.Is(err, pagination.ErrInvalidSort):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "unknown sort column", Field: "sort"})
+	case errors.Is(err, errMethodNotAllowed):
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+	case errors.As(err, &editorsErr):
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeJSON(w, http.StatusUnauthorized, errorResponse{Error: "only editors may set it", Field: editorsErr.field})
+	case errors.Is(err, errInvalidToken):
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeJSON(w, http.StatusUnauthorized, errorResponse{Error: "invalid token"})
+	case errors.Is(err, db.ErrInvalidReference):
+		writeJSON(w, http.StatusUnprocessableEntity, errorResponse{
+			Error: message(invalidReferenceMessages, dbErr.Constraint, "referenced row does not exist"),
+			Field: dbErr.Column,
+		})
+	case errors.Is(err, db.ErrReferenced):
+		writeJSON(w, http.StatusConflict, errorResponse{
+			Error: message(referencedMessages, dbErr.Constraint, "row is still referenced"),
+		})
+	case errors.As(err, &transitionErr):
+		writeJSON(w, http.StatusConflict, errorResponse{
+			Error: fmt.Sprintf("can't go from %s to %s", transitionErr.From, transitionErr.To),
+			Field: "status",
+		})
+	case errors.Is(err, dbmodels.ErrStaleObject):
+		writeJSON(w, http.StatusConflict, errorResponse{Error: "changed since it was loaded, reload it", Field: "version"})
+	case errors.Is(err, db.ErrConflict):
+		writeJSON(w, http.StatusConflict, errorResponse{Error: "value already exists", Field: dbErr.Column})
+	case errors.Is(err, db.ErrRequired):
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "value is required", Field: dbErr.Column})
+	default:
+		log.Printf("server: %v", err)
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: "internal server error"})
+	}
+}
+
+func message(messages map[string]string, constraint, fallback string) string {
+	if msg, ok := messages[constraint]; ok {
+		return msg
+	}
+	return fallback
+}
@@ -0,0 +1,291 @@
+// Package server exposes authors and articles over a JSON REST API.
+//
+// Handlers only talk to the repositories the Server was created with, so the
+// API can be exercised with net/http/httptest against repository.NewMemory.
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db/filter"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/db/pagination"
+	"github.com/gurleensethi/go-sql-boiler-example/repository"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Server routes the REST API:
+//
+//	GET    /authors                list authors
+//	POST   /authors                create an author
+//	GET    /authors/{id}           show an author, ?include=articles eager loads
+//	PATCH  /authors/{id}           update an author
+//	DELETE /authors/{id}           delete an author
+//	POST   /authors/{id}/restore   restore a deleted author
+//	GET    /authors/{id}/articles  list an author's articles
+//	GET    /articles               list articles
+//	POST   /articles               create an article
+//	GET    /articles/{id}          show an article
+//	PATCH  /articles/{id}          update an article
+//	DELETE /articles/{id}          delete an article
+//	POST   /articles/{id}/restore  restore a deleted article
+//	POST   /articles/{id}/status   change the status of an article
+//
+// Lists are paged and respond with {items, next_cursor, prev_cursor,
+// has_more}. ?limit= sets the page size and ?cursor= selects the page after
+// or before a previous one. ?deleted=include or ?deleted=only lists soft
+// deleted rows, which are left out otherwise. Every other parameter filters
+// or sorts the list as described by package filter, e.g.
+// ?title=like:Hello*&sort=-created_at.
+//
+// Only published articles are listed and shown, also as the articles of
+// an author, unless editors ask for the others with ?unpublished=include,
+// which fails with 401 Unauthorized for anonymous requests.
+//
+// Deletes are soft, a deleted author or article can be restored until it is
+// purged.
+//
+// Articles are created as drafts. POST /articles/{id}/status with
+// {"status": "published"} publishes one, {"status": "scheduled",
+// "publish_at": ...} schedules it; moves dbmodels.ArticleTransitions
+// doesn't allow fail with 409 Conflict.
+//
+// Timestamps are shown in the time zone of boil.GetLocation, every request
+// can ask for another one with ?tz=, e.g. ?tz=Europe/Berlin.
+//
+// Editors authenticate with "Authorization: Bearer <token>", see Editors.
+// Changes are recorded in the audit log with the name of the editor making
+// them, see dbmodels.WithActor, those of anonymous requests with none.
+// Requests with a token that isn't an editor's fail with 401 Unauthorized.
+type Server struct {
+	authors  repository.AuthorRepository
+	articles repository.ArticleRepository
+	// editors maps the names of editors to their tokens.
+	editors map[string]string
+	mux     *http.ServeMux
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// Editors lets the editors authenticate with the tokens in editors, which
+// maps their names to their tokens. Without it every request is anonymous.
+func Editors(editors map[string]string) Option {
+	return func(s *Server) { s.editors = editors }
+}
+
+// New returns a Server storing authors and articles in repos.
+func New(repos repository.Repositories, opts ...Option) *Server {
+	s := &Server{
+		authors:  repos.Authors,
+		articles: repos.Articles,
+		mux:      http.NewServeMux(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.mux.HandleFunc("/authors", s.handleAuthors)
+	s.mux.HandleFunc("/authors/", s.handleAuthors)
+	s.mux.HandleFunc("/articles", s.handleArticles)
+	s.mux.HandleFunc("/articles/", s.handleArticles)
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	loc, err := queryLocation(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	editor, err := s.authenticate(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), locationKey{}, loc)
+	ctx = context.WithValue(ctx, editorKey{}, editor)
+	ctx = dbmodels.WithActor(ctx, editor)
+	s.mux.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// editorKey is the context key of the name of the editor making a request,
+// "" for anonymous requests.
+type editorKey struct{}
+
+// authenticate returns the name of the editor whose token the request
+// carries, or "" if it carries none.
+func (s *Server) authenticate(r *http.Request) (string, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return "", nil
+	}
+
+	token := strings.TrimPrefix(header, "Bearer ")
+	if token == header || token == "" {
+		return "", errInvalidToken
+	}
+	// Every token is compared, in constant time, so the response time
+	// doesn't tell how much of a token is right.
+	var editor string
+	for name, t := range s.editors {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+			editor = name
+		}
+	}
+	if editor == "" {
+		return "", errInvalidToken
+	}
+	return editor, nil
+}
+
+// locationKey is the context key of the time zone selected with ?tz=.
+type locationKey struct{}
+
+// queryLocation reads the tz query parameter selecting the time zone of the
+// timestamps in the response.
+func queryLocation(r *http.Request) (*time.Location, error) {
+	v := r.URL.Query().Get("tz")
+	if v == "" {
+		return boil.GetLocation(), nil
+	}
+
+	loc, err := time.LoadLocation(v)
+	if err != nil {
+		return nil, validationError{field: "tz", msg: "must be a time zone such as Europe/Berlin"}
+	}
+	return loc, nil
+}
+
+// route splits the request path below the collection prefix into the
+// resource id and any trailing segments, e.g. "/authors/1/articles" with the
+// prefix "/authors" yields (1, ["articles"]). id is 0 for the collection
+// itself.
+func route(r *http.Request, prefix string) (id int, rest []string, err error) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	if path == "" {
+		return 0, nil, nil
+	}
+
+	segments := strings.Split(path, "/")
+	id, err = strconv.Atoi(segments[0])
+	if err != nil || id <= 0 {
+		return 0, nil, errNotFound
+	}
+
+	return id, segments[1:], nil
+}
+
+// queryInt reads a non-negative integer query parameter, returning def if it
+// is absent.
+func queryInt(r *http.Request, name string, def int) (int, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return def, nil
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil || i < 0 {
+		return 0, validationError{field: name, msg: "must be a non-negative integer"}
+	}
+	return i, nil
+}
+
+const maxLimit = 100
+
+// listParams reads the query parameters of a list: the filters and sort
+// order allowed by schema and the cursor and limit selecting the page.
+// deleted is read by queryDeleted, unpublished by queryPublished and tz by
+// queryLocation.
+func listParams(r *http.Request, schema *filter.Schema) (filter.Query, pagination.Params, error) {
+	limit, err := queryInt(r, "limit", pagination.DefaultLimit)
+	if err != nil {
+		return filter.Query{}, pagination.Params{}, err
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	q, err := schema.Parse(r.URL.Query(), "cursor", "limit", "deleted", "unpublished", "tz")
+	if err != nil {
+		return filter.Query{}, pagination.Params{}, err
+	}
+
+	return q, pagination.Params{
+		Cursor: r.URL.Query().Get("cursor"),
+		Sort:   q.Sort,
+		Limit:  limit,
+	}, nil
+}
+
+// queryDeleted reads the deleted query parameter selecting whether a list
+// includes soft deleted rows.
+func queryDeleted(r *http.Request) (repository.Deleted, error) {
+	v := r.URL.Query().Get("deleted")
+	if v == "" {
+		return repository.ExcludeDeleted, nil
+	}
+
+	d, err := repository.ParseDeleted(v)
+	if err != nil {
+		return 0, validationError{field: "deleted", msg: "must be exclude, include or only"}
+	}
+	return d, nil
+}
+
+// queryPublished reads the unpublished query parameter, reporting whether
+// a request is limited to published articles. Only editors may include the
+// others.
+func queryPublished(r *http.Request) (bool, error) {
+	switch r.URL.Query().Get("unpublished") {
+	case "", "exclude":
+		return true, nil
+	case "include":
+		if editor, _ := r.Context().Value(editorKey{}).(string); editor == "" {
+			return false, editorsOnlyError{field: "unpublished"}
+		}
+		return false, nil
+	default:
+		return false, validationError{field: "unpublished", msg: "must be exclude or include"}
+	}
+}
+
+// decode reads the JSON request body into v, rejecting unknown fields.
+func decode(r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return validationError{msg: "invalid request body: " + err.Error()}
+	}
+	return nil
+}
+
+// writeResult responds with v, its timestamps in the time zone selected with
+// ?tz=.
+func writeResult(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	loc, _ := r.Context().Value(locationKey{}).(*time.Location)
+	if loc == nil {
+		loc = boil.GetLocation()
+	}
+	writeJSON(w, status, dbmodels.InLocation(v, loc))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func methodNotAllowed(w http.ResponseWriter, allowed ...string) {
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	writeError(w, errMethodNotAllowed)
+}
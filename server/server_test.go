@@ -0,0 +1,320 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/gurleensethi/go-sql-boiler-example/repository"
+	"github.com/volatiletech/null/v8"
+)
+
+var created = time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+
+// editorToken is the token of the editor alice of the servers returned by
+// newTestServer.
+const editorToken = "secret"
+
+// newTestServer returns a Server over an in-memory repository holding
+// author 1 with the draft article 1 and the published article 2.
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	return newTestServerWith(t, repository.NewMemory(nil))
+}
+
+// newTestServerWith returns a Server over repos, after adding the rows
+// described by newTestServer.
+func newTestServerWith(t *testing.T, repos repository.Repositories) *Server {
+	t.Helper()
+
+	ctx := context.Background()
+
+	if err := repos.Authors.Insert(ctx, &dbmodels.Author{Name: "Jane", Email: "jane@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, article := range []*dbmodels.Article{
+		{Title: "Draft", AuthorID: 1, CreatedAt: null.TimeFrom(created)},
+		{Title: "Published", AuthorID: 1, CreatedAt: null.TimeFrom(created), Status: dbmodels.ArticleStatusPublished, PublishedAt: null.TimeFrom(created)},
+	} {
+		if err := repos.Articles.Insert(ctx, article); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return New(repos, Editors(map[string]string{"alice": editorToken}))
+}
+
+func serve(s *Server, method, target, body string) *httptest.ResponseRecorder {
+	return serveWithToken(s, method, target, body, "")
+}
+
+// serveWithToken serves the request authenticated with token, or
+// anonymously if it is empty.
+func serveWithToken(s *Server, method, target, body, token string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, r)
+	return w
+}
+
+func TestRoutes(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		status int
+		field  string
+	}{
+		{"list authors", http.MethodGet, "/authors", "", http.StatusOK, ""},
+		{"get author", http.MethodGet, "/authors/1", "", http.StatusOK, ""},
+		{"get author with articles", http.MethodGet, "/authors/1?include=articles", "", http.StatusOK, ""},
+		{"list author articles", http.MethodGet, "/authors/1/articles", "", http.StatusOK, ""},
+		{"create author", http.MethodPost, "/authors", `{"name": "John", "email": "john@example.com"}`, http.StatusCreated, ""},
+		{"create author without name", http.MethodPost, "/authors", `{"email": "john@example.com"}`, http.StatusBadRequest, "name"},
+		{"create author with invalid email", http.MethodPost, "/authors", `{"name": "John", "email": "john"}`, http.StatusBadRequest, "email"},
+		{"create author with unknown field", http.MethodPost, "/authors", `{"name": "John", "age": 40}`, http.StatusBadRequest, ""},
+		{"update author", http.MethodPatch, "/authors/1", `{"name": "Jane Doe"}`, http.StatusOK, ""},
+		{"delete referenced author", http.MethodDelete, "/authors/1", "", http.StatusConflict, ""},
+		{"restore undeleted author", http.MethodPost, "/authors/1/restore", "", http.StatusNotFound, ""},
+		{"missing author", http.MethodGet, "/authors/9", "", http.StatusNotFound, ""},
+		{"articles of missing author", http.MethodGet, "/authors/9/articles", "", http.StatusNotFound, ""},
+		{"invalid id", http.MethodGet, "/authors/abc", "", http.StatusNotFound, ""},
+		{"unknown route", http.MethodGet, "/authors/1/books", "", http.StatusNotFound, ""},
+		{"method not allowed", http.MethodPut, "/authors", "", http.StatusMethodNotAllowed, ""},
+
+		{"list articles", http.MethodGet, "/articles", "", http.StatusOK, ""},
+		{"get article", http.MethodGet, "/articles/2", "", http.StatusOK, ""},
+		{"create article", http.MethodPost, "/articles", `{"title": "New", "author_id": 1}`, http.StatusCreated, ""},
+		{"create article of missing author", http.MethodPost, "/articles", `{"title": "New", "author_id": 9}`, http.StatusUnprocessableEntity, "author_id"},
+		{"create article without title", http.MethodPost, "/articles", `{"author_id": 1}`, http.StatusBadRequest, "title"},
+		{"update article", http.MethodPatch, "/articles/1", `{"title": "Changed", "version": 0}`, http.StatusOK, ""},
+		{"update stale article", http.MethodPatch, "/articles/1", `{"title": "Changed", "version": 5}`, http.StatusConflict, "version"},
+		{"move article to missing author", http.MethodPatch, "/articles/1", `{"author_id": 9}`, http.StatusUnprocessableEntity, "author_id"},
+		{"publish article", http.MethodPost, "/articles/1/status", `{"status": "published"}`, http.StatusOK, ""},
+		{"schedule published article", http.MethodPost, "/articles/2/status", `{"status": "scheduled", "publish_at": "2030-01-01T00:00:00Z"}`, http.StatusConflict, "status"},
+		{"schedule without time", http.MethodPost, "/articles/1/status", `{"status": "scheduled"}`, http.StatusBadRequest, "publish_at"},
+		{"unknown status", http.MethodPost, "/articles/1/status", `{"status": "gone"}`, http.StatusBadRequest, "status"},
+		{"delete article", http.MethodDelete, "/articles/1", "", http.StatusNoContent, ""},
+		{"missing article", http.MethodGet, "/articles/9", "", http.StatusNotFound, ""},
+		{"status method not allowed", http.MethodGet, "/articles/1/status", "", http.StatusMethodNotAllowed, ""},
+
+		{"filter", http.MethodGet, "/articles?title=like:Pub*&sort=-created_at", "", http.StatusOK, ""},
+		{"unknown filter", http.MethodGet, "/articles?color=red", "", http.StatusBadRequest, "color"},
+		{"invalid operator", http.MethodGet, "/articles?title=near:x", "", http.StatusBadRequest, "title"},
+		{"invalid cursor", http.MethodGet, "/articles?cursor=abc", "", http.StatusBadRequest, "cursor"},
+		{"invalid limit", http.MethodGet, "/articles?limit=-1", "", http.StatusBadRequest, "limit"},
+		{"invalid deleted", http.MethodGet, "/articles?deleted=maybe", "", http.StatusBadRequest, "deleted"},
+		{"invalid unpublished", http.MethodGet, "/articles?unpublished=maybe", "", http.StatusBadRequest, "unpublished"},
+		{"invalid time zone", http.MethodGet, "/articles?tz=Nowhere/Else", "", http.StatusBadRequest, "tz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(newTestServer(t), tt.method, tt.target, tt.body)
+			if w.Code != tt.status {
+				t.Fatalf("%s %s = %d %s, want %d", tt.method, tt.target, w.Code, w.Body, tt.status)
+			}
+			if got := w.Header().Get("Content-Type"); tt.status != http.StatusNoContent && got != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", got)
+			}
+			if tt.status < 400 {
+				return
+			}
+
+			var resp errorResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+			if resp.Error == "" || resp.Field != tt.field {
+				t.Errorf("error = %+v, want a message for field %q", resp, tt.field)
+			}
+		})
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	w := serve(newTestServer(t), http.MethodPut, "/articles/1", "")
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("PUT /articles/1 = %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+	if got, want := w.Header().Get("Allow"), "GET, PATCH, DELETE"; got != want {
+		t.Errorf("Allow = %q, want %q", got, want)
+	}
+}
+
+// actorArticles records the actor of the articles it inserts.
+type actorArticles struct {
+	repository.ArticleRepository
+	actors []string
+}
+
+func (r *actorArticles) Insert(ctx context.Context, article *dbmodels.Article) error {
+	r.actors = append(r.actors, dbmodels.ActorFrom(ctx))
+	return r.ArticleRepository.Insert(ctx, article)
+}
+
+func TestActor(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		status int
+		actor  string
+	}{
+		{"anonymous", "", http.StatusCreated, ""},
+		{"editor", "Bearer " + editorToken, http.StatusCreated, "alice"},
+		{"unknown token", "Bearer guess", http.StatusUnauthorized, ""},
+		{"other scheme", "Basic " + editorToken, http.StatusUnauthorized, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos := repository.NewMemory(nil)
+			articles := &actorArticles{ArticleRepository: repos.Articles}
+			repos.Articles = articles
+			s := newTestServerWith(t, repos)
+			articles.actors = nil
+
+			r := httptest.NewRequest(http.MethodPost, "/articles", strings.NewReader(`{"title": "New", "author_id": 1}`))
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			// The actor can't be named by the client.
+			r.Header.Set("X-Actor", "mallory")
+			w := httptest.NewRecorder()
+			s.ServeHTTP(w, r)
+
+			if w.Code != tt.status {
+				t.Fatalf("POST /articles = %d %s, want %d", w.Code, w.Body, tt.status)
+			}
+			if tt.status == http.StatusUnauthorized {
+				if w.Header().Get("WWW-Authenticate") == "" {
+					t.Error("401 response without WWW-Authenticate")
+				}
+				if len(articles.actors) != 0 {
+					t.Errorf("unauthorized request inserted articles as %q", articles.actors)
+				}
+				return
+			}
+			if len(articles.actors) != 1 || articles.actors[0] != tt.actor {
+				t.Errorf("article inserted as %q, want %q", articles.actors, tt.actor)
+			}
+		})
+	}
+}
+
+func TestUnpublished(t *testing.T) {
+	tests := []struct {
+		target string
+		ids    []int
+	}{
+		{"/articles", []int{2}},
+		{"/articles?unpublished=include", []int{1, 2}},
+		{"/articles?unpublished=include&status=draft", []int{1}},
+		{"/articles?status=draft", nil},
+		{"/authors/1/articles", []int{2}},
+		{"/authors/1/articles?unpublished=include", []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			w := serveWithToken(newTestServer(t), http.MethodGet, tt.target, "", editorToken)
+			if w.Code != http.StatusOK {
+				t.Fatalf("GET %s = %d %s", tt.target, w.Code, w.Body)
+			}
+
+			var page struct {
+				Items []dbmodels.Article `json:"items"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&page); err != nil {
+				t.Fatal(err)
+			}
+			if got := articleIDs(page.Items); !equalIDs(got, tt.ids) {
+				t.Errorf("GET %s = articles %v, want %v", tt.target, got, tt.ids)
+			}
+		})
+	}
+
+	s := newTestServer(t)
+	if w := serve(s, http.MethodGet, "/articles/1", ""); w.Code != http.StatusNotFound {
+		t.Errorf("GET draft = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	if w := serveWithToken(s, http.MethodGet, "/articles/1?unpublished=include", "", editorToken); w.Code != http.StatusOK {
+		t.Errorf("GET draft with unpublished=include = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	// Anonymous clients can't ask for unpublished articles.
+	for _, target := range []string{
+		"/articles?unpublished=include",
+		"/articles/1?unpublished=include",
+		"/authors/1?include=articles&unpublished=include",
+		"/authors/1/articles?unpublished=include",
+	} {
+		if w := serve(s, http.MethodGet, target, ""); w.Code != http.StatusUnauthorized {
+			t.Errorf("anonymous GET %s = %d %s, want %d", target, w.Code, w.Body, http.StatusUnauthorized)
+		}
+	}
+
+	w := serve(s, http.MethodGet, "/authors/1?include=articles", "")
+	var author struct {
+		Articles []dbmodels.Article `json:"articles"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&author); err != nil {
+		t.Fatal(err)
+	}
+	if got := articleIDs(author.Articles); !equalIDs(got, []int{2}) {
+		t.Errorf("author articles = %v, want [2]", got)
+	}
+}
+
+func TestTimeZone(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"/articles/2", "2024-01-15T12:00:00Z"},
+		{"/articles/2?tz=America/New_York", "2024-01-15T07:00:00-05:00"},
+		{"/articles/2?tz=Asia/Kolkata", "2024-01-15T17:30:00+05:30"},
+		{"/articles?tz=Europe/Berlin", "2024-01-15T13:00:00+01:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			w := serve(newTestServer(t), http.MethodGet, tt.target, "")
+			if w.Code != http.StatusOK {
+				t.Fatalf("GET %s = %d %s", tt.target, w.Code, w.Body)
+			}
+			if want := `"created_at":"` + tt.want + `"`; !strings.Contains(w.Body.String(), want) {
+				t.Errorf("GET %s = %s, want %s", tt.target, w.Body, want)
+			}
+		})
+	}
+}
+
+func articleIDs(articles []dbmodels.Article) []int {
+	var ids []int
+	for _, a := range articles {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
+
+func equalIDs(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gurleensethi/go-sql-boiler-example/db"
+	dbmodels "github.com/gurleensethi/go-sql-boiler-example/db/models"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func newTagsCmd() *cobra.Command {
+	var authorID, limit int
+	var unpublished bool
+
+	cmd := &cobra.Command{
+		Use:   "tags",
+		Short: "Count the articles per tag",
+		Long: `Count the published articles having each tag, most used tags first.
+Deleted articles aren't counted, unpublished ones only with --unpublished.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var mods []qm.QueryMod
+			if authorID != 0 {
+				mods = append(mods, dbmodels.ArticleWhere.AuthorID.EQ(authorID))
+			}
+			if unpublished {
+				mods = append(mods, dbmodels.WithUnpublished())
+			}
+			if limit > 0 {
+				mods = append(mods, qm.Limit(limit))
+			}
+
+			counts, err := dbmodels.TagCloud(cmd.Context(), boil.GetContextDB(), mods...)
+			if err != nil {
+				return err
+			}
+
+			t := table{headers: []string{"TAG", "ARTICLES"}}
+			for _, c := range counts {
+				t.rows = append(t.rows, []string{c.Tag.Name, strconv.FormatInt(c.Count, 10)})
+			}
+
+			return printResult(cmd, counts, t)
+		},
+	}
+
+	cmd.Flags().IntVar(&authorID, "author-id", 0, "only count articles of this author")
+	cmd.Flags().IntVar(&limit, "limit", 0, "maximum number of tags, 0 lists all")
+	cmd.Flags().BoolVar(&unpublished, "unpublished", false, "also count articles that aren't published")
+
+	return cmd
+}
+
+func newArticlesTagCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tag <id> [tag]...",
+		Short: "Set the tags of an article",
+		Long: `Set the tags of an article to the given ones, creating tags that don't
+exist yet. Without tags the article's tags are removed. Tags are lower
+cased.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var tags dbmodels.TagSlice
+			err = db.WithTx(cmd.Context(), boil.GetContextDB(), func(tx boil.ContextExecutor) error {
+				tags, err = setArticleTags(cmd.Context(), tx, id, args[1:])
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			return printResult(cmd, tags, tagTable(tags...))
+		},
+	}
+}
+
+// setArticleTags replaces the tags of the article with the given id.
+func setArticleTags(ctx context.Context, tx boil.ContextExecutor, id int, names []string) (dbmodels.TagSlice, error) {
+	article, err := dbmodels.FindArticle(ctx, tx, id)
+	if err != nil {
+		return nil, db.Translate(err, dbmodels.TableNames.Article)
+	}
+
+	tags, err := dbmodels.FindOrCreateTags(ctx, tx, names...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := article.SetTags(ctx, tx, false, tags...); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
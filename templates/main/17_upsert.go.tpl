@@ -0,0 +1,239 @@
+{{- /*
+	Replaces main/17_upsert.go.tpl of the SQLBoiler 4.12.0 psql driver, see
+	replace in sqlboiler.toml. Tables with an integer version column are
+	locked optimistically: on conflict, Upsert only updates the row if its
+	version is still the one of the upserted model and returns a
+	*StaleObjectError otherwise. The bump_version trigger function
+	increments the column.
+
+	The updated_at column of tables with automatic timestamps is written on
+	conflict when updateColumns is a whitelist without it, unless
+	timestamps are skipped.
+
+	Upsert runs the hooks of every write with a context of its own, see
+	withWrite in db/models/hooks.go.
+*/ -}}
+{{- if or (not .Table.IsView) .Table.ViewCapabilities.CanUpsert -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+{{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $versioned := false -}}
+{{- range .Table.Columns -}}
+{{- if and (eq .Name "version") (eq .Type "int") -}}
+{{- $versioned = true -}}
+{{- end -}}
+{{- end -}}
+{{- $updatedAt := "" -}}
+{{- range .Table.Columns -}}
+{{- if and (eq .Name (or $.AutoColumns.Updated "updated_at")) (not $.NoAutoTimestamps) -}}
+{{- $updatedAt = .Name -}}
+{{- end -}}
+{{- end}}
+{{if .AddGlobal -}}
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *{{$alias.UpSingular}}) UpsertG({{if not .NoContext}}ctx context.Context, {{end -}} updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+{{end -}}
+
+{{if and .AddGlobal .AddPanic -}}
+// UpsertGP attempts an insert, and does an update or ignore on conflict. Panics on error.
+func (o *{{$alias.UpSingular}}) UpsertGP({{if not .NoContext}}ctx context.Context, {{end -}} updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, updateOnConflict, conflictColumns, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+{{if .AddPanic -}}
+// UpsertP attempts an insert using an executor, and does an update or ignore on conflict.
+// UpsertP panics on error.
+func (o *{{$alias.UpSingular}}) UpsertP({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) {
+	if err := o.Upsert({{if not .NoContext}}ctx, {{end -}} exec, updateOnConflict, conflictColumns, updateColumns, insertColumns); err != nil {
+		panic(boil.WrapErr(err))
+	}
+}
+
+{{end -}}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+{{- if $versioned}}
+// The version of o is always inserted. An update only happens if the conflicting row still
+// has that version, o.Version is set to the version of the row afterwards. Upsert returns
+// a *StaleObjectError if the row was changed in the meantime.
+{{- end}}
+{{- if $updatedAt}}
+// On conflict, {{$updatedAt}} is updated even if updateColumns is a whitelist without it{{if not .NoContext}}, unless timestamps are skipped{{end}}.
+{{- end}}
+func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
+	}
+
+	{{- template "timestamp_upsert_helper" . }}
+
+	{{if not .NoHooks -}}
+	{{if not .NoContext -}}
+	ctx = withWrite(ctx)
+	{{end -}}
+	if err := o.doBeforeUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec); err != nil {
+		return err
+	}
+	{{- end}}
+
+	{{if $updatedAt -}}
+	if {{if not .NoContext}}!boil.TimestampsAreSkipped(ctx) && {{end}}updateColumns.IsWhitelist() && !strmangle.SetInclude("{{$updatedAt}}", updateColumns.Cols) {
+		updateColumns = boil.Whitelist(strmangle.SetMerge(updateColumns.Cols, []string{"{{$updatedAt}}"})...)
+	}
+
+	{{end -}}
+	nzDefaults := queries.NonZeroDefaultSet({{$alias.DownSingular}}ColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	{{$alias.DownSingular}}UpsertCacheMut.RLock()
+	cache, cached := {{$alias.DownSingular}}UpsertCache[key]
+	{{$alias.DownSingular}}UpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			{{$alias.DownSingular}}AllColumns,
+			{{$alias.DownSingular}}ColumnsWithDefault,
+			{{$alias.DownSingular}}ColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			{{$alias.DownSingular}}AllColumns,
+			{{$alias.DownSingular}}PrimaryKeyColumns,
+		)
+		{{if filterColumnsByAuto true .Table.Columns }}
+		insert = strmangle.SetComplement(insert, {{$alias.DownSingular}}GeneratedColumns)
+		update = strmangle.SetComplement(update, {{$alias.DownSingular}}GeneratedColumns)
+		{{- end }}
+
+		{{- if $versioned}}
+		insert = strmangle.SetMerge(insert, []string{"version"})
+		ret = strmangle.SetMerge(ret, []string{"version"})
+		update = strmangle.SetComplement(update, []string{"version"})
+		{{- end}}
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("{{.PkgName}}: unable to upsert {{.Table.Name}}, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len({{$alias.DownSingular}}PrimaryKeyColumns))
+			copy(conflict, {{$alias.DownSingular}}PrimaryKeyColumns)
+		}
+		{{if $versioned -}}
+		cache.query = buildUpsertQueryPostgres(dialect, "{{$schemaTable}}", updateOnConflict, nil, update, conflict, insert)
+		if updateOnConflict {
+			cache.query += " WHERE {{$schemaTable}}.{{"version" | $.Quotes}} = EXCLUDED.{{"version" | $.Quotes}}"
+		}
+		cache.query += " RETURNING " + strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ", ")
+		{{else -}}
+		cache.query = buildUpsertQueryPostgres(dialect, "{{$schemaTable}}", updateOnConflict, ret, update, conflict, insert)
+		{{end}}
+
+		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	{{if .NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	{{else -}}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	{{end -}}
+
+	if len(cache.retMapping) != 0 {
+		{{if .NoContext -}}
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		{{else -}}
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		{{end -}}
+		{{if $versioned -}}
+		if errors.Is(err, sql.ErrNoRows) && updateOnConflict {
+			return &StaleObjectError{Table: "{{.Table.Name}}", Rows: 1}
+		}
+		{{end -}}
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		{{if .NoContext -}}
+		_, err = exec.Exec(cache.query, vals...)
+		{{else -}}
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+		{{end -}}
+	}
+	if err != nil {
+		return errors.Wrap(err, "{{.PkgName}}: unable to upsert {{.Table.Name}}")
+	}
+
+	if !cached {
+		{{$alias.DownSingular}}UpsertCacheMut.Lock()
+		{{$alias.DownSingular}}UpsertCache[key] = cache
+		{{$alias.DownSingular}}UpsertCacheMut.Unlock()
+	}
+
+	{{if not .NoHooks -}}
+	return o.doAfterUpsertHooks({{if not .NoContext}}ctx, {{end -}} exec)
+	{{- else -}}
+	return nil
+	{{- end}}
+}
+{{end}}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gurleensethi/go-sql-boiler-example/publisher"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func newWorkerCmd() *cobra.Command {
+	var (
+		interval  time.Duration
+		batchSize int
+		once      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "worker",
+		Short: "Publish scheduled articles when they are due",
+		Long: `Publish scheduled articles once their publish time has come, polling
+every --interval until interrupted. Any number of workers can run at the
+same time, every article is published by exactly one of them. --once
+publishes the due articles and exits, e.g. to run from cron.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if interval <= 0 {
+				return fmt.Errorf("invalid --interval %s, must be positive", interval)
+			}
+			if batchSize <= 0 {
+				return fmt.Errorf("invalid --batch-size %d, must be positive", batchSize)
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			w := publisher.New(boil.GetContextDB(),
+				publisher.Interval(interval),
+				publisher.BatchSize(batchSize),
+			)
+
+			if !once {
+				log.Printf("publishing scheduled articles every %s", interval)
+				return w.Run(ctx)
+			}
+
+			published, err := w.PublishAllDue(ctx)
+			for _, article := range published {
+				log.Printf("published article %d", article.ID)
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().DurationVar(&interval, "interval", publisher.DefaultInterval, "time to wait between polls for due articles")
+	cmd.Flags().IntVar(&batchSize, "batch-size", publisher.DefaultBatchSize, "maximum number of articles published per transaction")
+	cmd.Flags().BoolVar(&once, "once", false, "publish the articles that are due and exit")
+
+	return cmd
+}